@@ -0,0 +1,4 @@
+// Package management is the generated code of the EventManagement gRPC service.
+package management
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative api/management/management.proto
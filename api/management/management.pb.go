@@ -0,0 +1,3064 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: api/management/management.proto
+
+package management
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EmptyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyResponse) Reset() {
+	*x = EmptyResponse{}
+	mi := &file_api_management_management_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyResponse) ProtoMessage() {}
+
+func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{0}
+}
+
+type EventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRequest) Reset() {
+	*x = EventRequest{}
+	mi := &file_api_management_management_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRequest) ProtoMessage() {}
+
+func (x *EventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRequest.ProtoReflect.Descriptor instead.
+func (*EventRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type EventResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	About             string                 `protobuf:"bytes,3,opt,name=about,proto3" json:"about,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Location          string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Status            string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	MaxAttendees      int32                  `protobuf:"varint,7,opt,name=max_attendees,json=maxAttendees,proto3" json:"max_attendees,omitempty"`
+	CurrentAttendance int32                  `protobuf:"varint,8,opt,name=current_attendance,json=currentAttendance,proto3" json:"current_attendance,omitempty"`
+	Creator           string                 `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
+	Version           int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	Settings          *Settings              `protobuf:"bytes,11,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EventResponse) Reset() {
+	*x = EventResponse{}
+	mi := &file_api_management_management_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResponse) ProtoMessage() {}
+
+func (x *EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventResponse) GetAbout() string {
+	if x != nil {
+		return x.About
+	}
+	return ""
+}
+
+func (x *EventResponse) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *EventResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *EventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EventResponse) GetMaxAttendees() int32 {
+	if x != nil {
+		return x.MaxAttendees
+	}
+	return 0
+}
+
+func (x *EventResponse) GetCurrentAttendance() int32 {
+	if x != nil {
+		return x.CurrentAttendance
+	}
+	return 0
+}
+
+func (x *EventResponse) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *EventResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EventResponse) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Settings leave the registration window and the cancellation cut-off unset
+// when the event uses the defaults of the service.
+type Settings struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RequiresApproval     bool                   `protobuf:"varint,1,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	RegistrationOpensAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_opens_at,json=registrationOpensAt,proto3" json:"registration_opens_at,omitempty"`
+	RegistrationClosesAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=registration_closes_at,json=registrationClosesAt,proto3" json:"registration_closes_at,omitempty"`
+	CancellationCutoff   *durationpb.Duration   `protobuf:"bytes,4,opt,name=cancellation_cutoff,json=cancellationCutoff,proto3" json:"cancellation_cutoff,omitempty"`
+	MaxGuests            int32                  `protobuf:"varint,5,opt,name=max_guests,json=maxGuests,proto3" json:"max_guests,omitempty"`
+	AllowTransfers       bool                   `protobuf:"varint,6,opt,name=allow_transfers,json=allowTransfers,proto3" json:"allow_transfers,omitempty"`
+	Visibility           string                 `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Settings) Reset() {
+	*x = Settings{}
+	mi := &file_api_management_management_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settings) ProtoMessage() {}
+
+func (x *Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Settings) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *Settings) GetRegistrationOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationOpensAt
+	}
+	return nil
+}
+
+func (x *Settings) GetRegistrationClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationClosesAt
+	}
+	return nil
+}
+
+func (x *Settings) GetCancellationCutoff() *durationpb.Duration {
+	if x != nil {
+		return x.CancellationCutoff
+	}
+	return nil
+}
+
+func (x *Settings) GetMaxGuests() int32 {
+	if x != nil {
+		return x.MaxGuests
+	}
+	return 0
+}
+
+func (x *Settings) GetAllowTransfers() bool {
+	if x != nil {
+		return x.AllowTransfers
+	}
+	return false
+}
+
+func (x *Settings) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// UpdateSettingsRequest changes only the settings that are set.
+type UpdateSettingsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	EventId              int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	RequiresApproval     *bool                  `protobuf:"varint,2,opt,name=requires_approval,json=requiresApproval,proto3,oneof" json:"requires_approval,omitempty"`
+	RegistrationOpensAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=registration_opens_at,json=registrationOpensAt,proto3" json:"registration_opens_at,omitempty"`
+	RegistrationClosesAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=registration_closes_at,json=registrationClosesAt,proto3" json:"registration_closes_at,omitempty"`
+	CancellationCutoff   *durationpb.Duration   `protobuf:"bytes,5,opt,name=cancellation_cutoff,json=cancellationCutoff,proto3" json:"cancellation_cutoff,omitempty"`
+	MaxGuests            *int32                 `protobuf:"varint,6,opt,name=max_guests,json=maxGuests,proto3,oneof" json:"max_guests,omitempty"`
+	AllowTransfers       *bool                  `protobuf:"varint,7,opt,name=allow_transfers,json=allowTransfers,proto3,oneof" json:"allow_transfers,omitempty"`
+	Visibility           *string                `protobuf:"bytes,8,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_api_management_management_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateSettingsRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetRequiresApproval() bool {
+	if x != nil && x.RequiresApproval != nil {
+		return *x.RequiresApproval
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetRegistrationOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationOpensAt
+	}
+	return nil
+}
+
+func (x *UpdateSettingsRequest) GetRegistrationClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationClosesAt
+	}
+	return nil
+}
+
+func (x *UpdateSettingsRequest) GetCancellationCutoff() *durationpb.Duration {
+	if x != nil {
+		return x.CancellationCutoff
+	}
+	return nil
+}
+
+func (x *UpdateSettingsRequest) GetMaxGuests() int32 {
+	if x != nil && x.MaxGuests != nil {
+		return *x.MaxGuests
+	}
+	return 0
+}
+
+func (x *UpdateSettingsRequest) GetAllowTransfers() bool {
+	if x != nil && x.AllowTransfers != nil {
+		return *x.AllowTransfers
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+type RegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrationRequest) Reset() {
+	*x = RegistrationRequest{}
+	mi := &file_api_management_management_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationRequest) ProtoMessage() {}
+
+func (x *RegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationRequest.ProtoReflect.Descriptor instead.
+func (*RegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegistrationRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RegistrationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Registration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	RegisteredAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	Cancellations int32                  `protobuf:"varint,6,opt,name=cancellations,proto3" json:"cancellations,omitempty"`
+	CheckedInAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	TicketTypeId  int64                  `protobuf:"varint,8,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Guests        int32                  `protobuf:"varint,9,opt,name=guests,proto3" json:"guests,omitempty"`
+	Answers       []*Answer              `protobuf:"bytes,10,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registration) Reset() {
+	*x = Registration{}
+	mi := &file_api_management_management_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registration) ProtoMessage() {}
+
+func (x *Registration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registration.ProtoReflect.Descriptor instead.
+func (*Registration) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Registration) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Registration) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Registration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Registration) GetRegisteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return nil
+}
+
+func (x *Registration) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Registration) GetCancellations() int32 {
+	if x != nil {
+		return x.Cancellations
+	}
+	return 0
+}
+
+func (x *Registration) GetCheckedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return nil
+}
+
+func (x *Registration) GetTicketTypeId() int64 {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return 0
+}
+
+func (x *Registration) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+func (x *Registration) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type RegistrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*Registration        `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrationsResponse) Reset() {
+	*x = RegistrationsResponse{}
+	mi := &file_api_management_management_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationsResponse) ProtoMessage() {}
+
+func (x *RegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegistrationsResponse) GetRegistrations() []*Registration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+// FieldChange holds the old and the new value of a field encoded as JSON.
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_api_management_management_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type Revision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	Snapshot      *EventResponse         `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_api_management_management_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Revision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Revision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Revision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Revision) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *Revision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *Revision) GetSnapshot() *EventResponse {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type GetHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_api_management_management_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetHistoryResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionRequest) Reset() {
+	*x = GetRevisionRequest{}
+	mi := &file_api_management_management_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionRequest) ProtoMessage() {}
+
+func (x *GetRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRevisionRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *GetRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// GetTicketRequest asks for the ticket of an active registration, with qr also as a PNG QR code.
+type GetTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Qr            bool                   `protobuf:"varint,3,opt,name=qr,proto3" json:"qr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	mi := &file_api_management_management_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTicketRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *GetTicketRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTicketRequest) GetQr() bool {
+	if x != nil {
+		return x.Qr
+	}
+	return false
+}
+
+type Ticket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Qr            []byte                 `protobuf:"bytes,4,opt,name=qr,proto3" json:"qr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_api_management_management_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Ticket) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Ticket) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Ticket) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Ticket) GetQr() []byte {
+	if x != nil {
+		return x.Qr
+	}
+	return nil
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_api_management_management_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckInRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Attendance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Registered    int32                  `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+	CheckedIn     int32                  `protobuf:"varint,3,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	Guests        int32                  `protobuf:"varint,4,opt,name=guests,proto3" json:"guests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_api_management_management_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Attendance) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Attendance) GetRegistered() int32 {
+	if x != nil {
+		return x.Registered
+	}
+	return 0
+}
+
+func (x *Attendance) GetCheckedIn() int32 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+func (x *Attendance) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+type TicketType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       int64                  `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Sold          int32                  `protobuf:"varint,5,opt,name=sold,proto3" json:"sold,omitempty"`
+	SaleStartsAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"`
+	SaleEndsAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	Hidden        bool                   `protobuf:"varint,8,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketType) Reset() {
+	*x = TicketType{}
+	mi := &file_api_management_management_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketType) ProtoMessage() {}
+
+func (x *TicketType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketType.ProtoReflect.Descriptor instead.
+func (*TicketType) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TicketType) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TicketType) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *TicketType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketType) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *TicketType) GetSold() int32 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
+}
+
+func (x *TicketType) GetSaleStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleStartsAt
+	}
+	return nil
+}
+
+func (x *TicketType) GetSaleEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return nil
+}
+
+func (x *TicketType) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// CreateTicketTypeRequest leaves a bound of the sale window open when it is not set.
+type CreateTicketTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	SaleStartsAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"`
+	SaleEndsAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	Hidden        bool                   `protobuf:"varint,6,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeRequest) Reset() {
+	*x = CreateTicketTypeRequest{}
+	mi := &file_api_management_management_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeRequest) ProtoMessage() {}
+
+func (x *CreateTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateTicketTypeRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *CreateTicketTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateTicketTypeRequest) GetSaleStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleStartsAt
+	}
+	return nil
+}
+
+func (x *CreateTicketTypeRequest) GetSaleEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return nil
+}
+
+func (x *CreateTicketTypeRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type CreateTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeResponse) Reset() {
+	*x = CreateTicketTypeResponse{}
+	mi := &file_api_management_management_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeResponse) ProtoMessage() {}
+
+func (x *CreateTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateTicketTypeResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTicketTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypes   []*TicketType          `protobuf:"bytes,1,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketTypesResponse) Reset() {
+	*x = GetTicketTypesResponse{}
+	mi := &file_api_management_management_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketTypesResponse) ProtoMessage() {}
+
+func (x *GetTicketTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketTypesResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketTypesResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTicketTypesResponse) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+type ChangeGuestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Guests        int32                  `protobuf:"varint,3,opt,name=guests,proto3" json:"guests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeGuestsRequest) Reset() {
+	*x = ChangeGuestsRequest{}
+	mi := &file_api_management_management_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeGuestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeGuestsRequest) ProtoMessage() {}
+
+func (x *ChangeGuestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeGuestsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeGuestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChangeGuestsRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ChangeGuestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeGuestsRequest) GetGuests() int32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+// RegisterGroupRequest registers all the users or none of them unless partial is set.
+type RegisterGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	TicketTypeId  int64                  `protobuf:"varint,3,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Partial       bool                   `protobuf:"varint,4,opt,name=partial,proto3" json:"partial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterGroupRequest) Reset() {
+	*x = RegisterGroupRequest{}
+	mi := &file_api_management_management_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterGroupRequest) ProtoMessage() {}
+
+func (x *RegisterGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterGroupRequest.ProtoReflect.Descriptor instead.
+func (*RegisterGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RegisterGroupRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RegisterGroupRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *RegisterGroupRequest) GetTicketTypeId() int64 {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return 0
+}
+
+func (x *RegisterGroupRequest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+// GroupOutcome is registered, pending, skipped or failed. A skipped or failed user
+// has the ErrorInfo reason and the message of the error, unless an all-or-none
+// registration skipped them.
+type GroupOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupOutcome) Reset() {
+	*x = GroupOutcome{}
+	mi := &file_api_management_management_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupOutcome) ProtoMessage() {}
+
+func (x *GroupOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupOutcome.ProtoReflect.Descriptor instead.
+func (*GroupOutcome) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GroupOutcome) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GroupOutcome) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *GroupOutcome) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GroupOutcome) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RegisterGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcomes      []*GroupOutcome        `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterGroupResponse) Reset() {
+	*x = RegisterGroupResponse{}
+	mi := &file_api_management_management_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterGroupResponse) ProtoMessage() {}
+
+func (x *RegisterGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterGroupResponse.ProtoReflect.Descriptor instead.
+func (*RegisterGroupResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RegisterGroupResponse) GetOutcomes() []*GroupOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+type TransferRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	FromUserId    string                 `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferRegistrationRequest) Reset() {
+	*x = TransferRegistrationRequest{}
+	mi := &file_api_management_management_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRegistrationRequest) ProtoMessage() {}
+
+func (x *TransferRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*TransferRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransferRegistrationRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *TransferRegistrationRequest) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *TransferRegistrationRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+type Question struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       int64                  `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	Required      bool                   `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	mi := &file_api_management_management_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Question) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Question) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Question) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Question) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Question) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Question) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Question) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// Answer holds the text, the chosen options or "true" or "false" for a boolean question.
+type Answer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	mi := &file_api_management_management_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Answer) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *Answer) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CreateQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuestionRequest) Reset() {
+	*x = CreateQuestionRequest{}
+	mi := &file_api_management_management_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateQuestionRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *CreateQuestionRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CreateQuestionRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type CreateQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuestionResponse) Reset() {
+	*x = CreateQuestionResponse{}
+	mi := &file_api_management_management_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionResponse) ProtoMessage() {}
+
+func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateQuestionResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*Question            `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuestionsResponse) Reset() {
+	*x = GetQuestionsResponse{}
+	mi := &file_api_management_management_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionsResponse) ProtoMessage() {}
+
+func (x *GetQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetQuestionsResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type DeleteQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionRequest) Reset() {
+	*x = DeleteQuestionRequest{}
+	mi := &file_api_management_management_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteQuestionRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *DeleteQuestionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ExportAnswersResponse is the registration form with the answers of the active
+// and pending registrations.
+type ExportAnswersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Questions     []*Question            `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	Registrations []*Registration        `protobuf:"bytes,3,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAnswersResponse) Reset() {
+	*x = ExportAnswersResponse{}
+	mi := &file_api_management_management_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAnswersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAnswersResponse) ProtoMessage() {}
+
+func (x *ExportAnswersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAnswersResponse.ProtoReflect.Descriptor instead.
+func (*ExportAnswersResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportAnswersResponse) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ExportAnswersResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ExportAnswersResponse) GetRegistrations() []*Registration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	EventId       int64                  `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_api_management_management_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Invite) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// CreateInviteRequest creates a personal invite for user_id, or one that can be
+// shared as a link when user_id is empty.
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_api_management_management_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateInviteRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitesResponse) Reset() {
+	*x = GetInvitesResponse{}
+	mi := &file_api_management_management_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesResponse) ProtoMessage() {}
+
+func (x *GetInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_api_management_management_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RevokeInviteRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RevokeInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Organizer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organizer) Reset() {
+	*x = Organizer{}
+	mi := &file_api_management_management_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organizer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organizer) ProtoMessage() {}
+
+func (x *Organizer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organizer.ProtoReflect.Descriptor instead.
+func (*Organizer) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Organizer) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Organizer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Organizer) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Organizer) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type GetOrganizersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizers    []*Organizer           `protobuf:"bytes,1,rep,name=organizers,proto3" json:"organizers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizersResponse) Reset() {
+	*x = GetOrganizersResponse{}
+	mi := &file_api_management_management_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizersResponse) ProtoMessage() {}
+
+func (x *GetOrganizersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizersResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizersResponse) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetOrganizersResponse) GetOrganizers() []*Organizer {
+	if x != nil {
+		return x.Organizers
+	}
+	return nil
+}
+
+// AddOrganizerRequest adds an editor, checkin or viewer, or changes the role of an organizer.
+type AddOrganizerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrganizerRequest) Reset() {
+	*x = AddOrganizerRequest{}
+	mi := &file_api_management_management_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrganizerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrganizerRequest) ProtoMessage() {}
+
+func (x *AddOrganizerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrganizerRequest.ProtoReflect.Descriptor instead.
+func (*AddOrganizerRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddOrganizerRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *AddOrganizerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddOrganizerRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type OrganizerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizerRequest) Reset() {
+	*x = OrganizerRequest{}
+	mi := &file_api_management_management_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizerRequest) ProtoMessage() {}
+
+func (x *OrganizerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizerRequest.ProtoReflect.Descriptor instead.
+func (*OrganizerRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OrganizerRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *OrganizerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// TransferOwnershipRequest makes user_id the owner, the previous owner stays an editor.
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int64                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_api_management_management_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_management_management_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_api_management_management_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TransferOwnershipRequest) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+var File_api_management_management_proto protoreflect.FileDescriptor
+
+const file_api_management_management_proto_rawDesc = "" +
+	"\n" +
+	"\x1fapi/management/management.proto\x12\n" +
+	"management\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"\x0f\n" +
+	"\rEmptyResponse\")\n" +
+	"\fEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\"\xf4\x02\n" +
+	"\rEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05about\x18\x03 \x01(\tR\x05about\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12#\n" +
+	"\rmax_attendees\x18\a \x01(\x05R\fmaxAttendees\x12-\n" +
+	"\x12current_attendance\x18\b \x01(\x05R\x11currentAttendance\x12\x18\n" +
+	"\acreator\x18\t \x01(\tR\acreator\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\x120\n" +
+	"\bsettings\x18\v \x01(\v2\x14.management.SettingsR\bsettings\"\x8d\x03\n" +
+	"\bSettings\x12+\n" +
+	"\x11requires_approval\x18\x01 \x01(\bR\x10requiresApproval\x12N\n" +
+	"\x15registration_opens_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x13registrationOpensAt\x12P\n" +
+	"\x16registration_closes_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14registrationClosesAt\x12J\n" +
+	"\x13cancellation_cutoff\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x12cancellationCutoff\x12\x1d\n" +
+	"\n" +
+	"max_guests\x18\x05 \x01(\x05R\tmaxGuests\x12'\n" +
+	"\x0fallow_transfers\x18\x06 \x01(\bR\x0eallowTransfers\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\a \x01(\tR\n" +
+	"visibility\"\x91\x04\n" +
+	"\x15UpdateSettingsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x120\n" +
+	"\x11requires_approval\x18\x02 \x01(\bH\x00R\x10requiresApproval\x88\x01\x01\x12N\n" +
+	"\x15registration_opens_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x13registrationOpensAt\x12P\n" +
+	"\x16registration_closes_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x14registrationClosesAt\x12J\n" +
+	"\x13cancellation_cutoff\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x12cancellationCutoff\x12\"\n" +
+	"\n" +
+	"max_guests\x18\x06 \x01(\x05H\x01R\tmaxGuests\x88\x01\x01\x12,\n" +
+	"\x0fallow_transfers\x18\a \x01(\bH\x02R\x0eallowTransfers\x88\x01\x01\x12#\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tH\x03R\n" +
+	"visibility\x88\x01\x01B\x14\n" +
+	"\x12_requires_approvalB\r\n" +
+	"\v_max_guestsB\x12\n" +
+	"\x10_allow_transfersB\r\n" +
+	"\v_visibility\"I\n" +
+	"\x13RegistrationRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xac\x03\n" +
+	"\fRegistration\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12?\n" +
+	"\rregistered_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredAt\x12=\n" +
+	"\fcancelled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12$\n" +
+	"\rcancellations\x18\x06 \x01(\x05R\rcancellations\x12>\n" +
+	"\rchecked_in_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcheckedInAt\x12$\n" +
+	"\x0eticket_type_id\x18\b \x01(\x03R\fticketTypeId\x12\x16\n" +
+	"\x06guests\x18\t \x01(\x05R\x06guests\x12,\n" +
+	"\aanswers\x18\n" +
+	" \x03(\v2\x12.management.AnswerR\aanswers\"W\n" +
+	"\x15RegistrationsResponse\x12>\n" +
+	"\rregistrations\x18\x01 \x03(\v2\x18.management.RegistrationR\rregistrations\"]\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xf9\x01\n" +
+	"\bRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x129\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x121\n" +
+	"\achanges\x18\x05 \x03(\v2\x17.management.FieldChangeR\achanges\x125\n" +
+	"\bsnapshot\x18\x06 \x01(\v2\x19.management.EventResponseR\bsnapshot\"H\n" +
+	"\x12GetHistoryResponse\x122\n" +
+	"\trevisions\x18\x01 \x03(\v2\x14.management.RevisionR\trevisions\"K\n" +
+	"\x12GetRevisionRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\"V\n" +
+	"\x10GetTicketRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02qr\x18\x03 \x01(\bR\x02qr\"b\n" +
+	"\x06Ticket\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02qr\x18\x04 \x01(\fR\x02qr\"&\n" +
+	"\x0eCheckInRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"~\n" +
+	"\n" +
+	"Attendance\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x1e\n" +
+	"\n" +
+	"registered\x18\x02 \x01(\x05R\n" +
+	"registered\x12\x1d\n" +
+	"\n" +
+	"checked_in\x18\x03 \x01(\x05R\tcheckedIn\x12\x16\n" +
+	"\x06guests\x18\x04 \x01(\x05R\x06guests\"\x93\x02\n" +
+	"\n" +
+	"TicketType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x03R\aeventId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\x12\x12\n" +
+	"\x04sold\x18\x05 \x01(\x05R\x04sold\x12@\n" +
+	"\x0esale_starts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fsaleStartsAt\x12<\n" +
+	"\fsale_ends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"saleEndsAt\x12\x16\n" +
+	"\x06hidden\x18\b \x01(\bR\x06hidden\"\xfc\x01\n" +
+	"\x17CreateTicketTypeRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12@\n" +
+	"\x0esale_starts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fsaleStartsAt\x12<\n" +
+	"\fsale_ends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"saleEndsAt\x12\x16\n" +
+	"\x06hidden\x18\x06 \x01(\bR\x06hidden\"*\n" +
+	"\x18CreateTicketTypeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"S\n" +
+	"\x16GetTicketTypesResponse\x129\n" +
+	"\fticket_types\x18\x01 \x03(\v2\x16.management.TicketTypeR\vticketTypes\"a\n" +
+	"\x13ChangeGuestsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06guests\x18\x03 \x01(\x05R\x06guests\"\x8c\x01\n" +
+	"\x14RegisterGroupRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12$\n" +
+	"\x0eticket_type_id\x18\x03 \x01(\x03R\fticketTypeId\x12\x18\n" +
+	"\apartial\x18\x04 \x01(\bR\apartial\"s\n" +
+	"\fGroupOutcome\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"M\n" +
+	"\x15RegisterGroupResponse\x124\n" +
+	"\boutcomes\x18\x01 \x03(\v2\x18.management.GroupOutcomeR\boutcomes\"x\n" +
+	"\x1bTransferRegistrationRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\tR\btoUserId\"\xb1\x01\n" +
+	"\bQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x03R\aeventId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\"A\n" +
+	"\x06Answer\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"\xae\x01\n" +
+	"\x15CreateQuestionRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\"(\n" +
+	"\x16CreateQuestionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"J\n" +
+	"\x14GetQuestionsResponse\x122\n" +
+	"\tquestions\x18\x01 \x03(\v2\x14.management.QuestionR\tquestions\"B\n" +
+	"\x15DeleteQuestionRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\xa6\x01\n" +
+	"\x15ExportAnswersResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x122\n" +
+	"\tquestions\x18\x02 \x03(\v2\x14.management.QuestionR\tquestions\x12>\n" +
+	"\rregistrations\x18\x03 \x03(\v2\x18.management.RegistrationR\rregistrations\"\xe5\x01\n" +
+	"\x06Invite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"I\n" +
+	"\x13CreateInviteRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"B\n" +
+	"\x12GetInvitesResponse\x12,\n" +
+	"\ainvites\x18\x01 \x03(\v2\x12.management.InviteR\ainvites\"D\n" +
+	"\x13RevokeInviteRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x8a\x01\n" +
+	"\tOrganizer\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x125\n" +
+	"\badded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"N\n" +
+	"\x15GetOrganizersResponse\x125\n" +
+	"\n" +
+	"organizers\x18\x01 \x03(\v2\x15.management.OrganizerR\n" +
+	"organizers\"]\n" +
+	"\x13AddOrganizerRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"F\n" +
+	"\x10OrganizerRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"N\n" +
+	"\x18TransferOwnershipRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x03R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId2\xdc\x10\n" +
+	"\x0fEventManagement\x12F\n" +
+	"\n" +
+	"GetHistory\x12\x18.management.EventRequest\x1a\x1e.management.GetHistoryResponse\x12H\n" +
+	"\vGetRevision\x12\x1e.management.GetRevisionRequest\x1a\x19.management.EventResponse\x12>\n" +
+	"\aRestore\x12\x18.management.EventRequest\x1a\x19.management.EmptyResponse\x12=\n" +
+	"\vGetSettings\x12\x18.management.EventRequest\x1a\x14.management.Settings\x12N\n" +
+	"\x0eUpdateSettings\x12!.management.UpdateSettingsRequest\x1a\x19.management.EmptyResponse\x12I\n" +
+	"\n" +
+	"GetPending\x12\x18.management.EventRequest\x1a!.management.RegistrationsResponse\x12E\n" +
+	"\aApprove\x12\x1f.management.RegistrationRequest\x1a\x19.management.EmptyResponse\x12D\n" +
+	"\x06Reject\x12\x1f.management.RegistrationRequest\x1a\x19.management.EmptyResponse\x12L\n" +
+	"\x0fGetRegistration\x12\x1f.management.RegistrationRequest\x1a\x18.management.Registration\x12=\n" +
+	"\tGetTicket\x12\x1c.management.GetTicketRequest\x1a\x12.management.Ticket\x12?\n" +
+	"\aCheckIn\x12\x1a.management.CheckInRequest\x1a\x18.management.Registration\x12A\n" +
+	"\rGetAttendance\x12\x18.management.EventRequest\x1a\x16.management.Attendance\x12]\n" +
+	"\x10CreateTicketType\x12#.management.CreateTicketTypeRequest\x1a$.management.CreateTicketTypeResponse\x12N\n" +
+	"\x0eGetTicketTypes\x12\x18.management.EventRequest\x1a\".management.GetTicketTypesResponse\x12J\n" +
+	"\fChangeGuests\x12\x1f.management.ChangeGuestsRequest\x1a\x19.management.EmptyResponse\x12T\n" +
+	"\rRegisterGroup\x12 .management.RegisterGroupRequest\x1a!.management.RegisterGroupResponse\x12Z\n" +
+	"\x14TransferRegistration\x12'.management.TransferRegistrationRequest\x1a\x19.management.EmptyResponse\x12W\n" +
+	"\x0eCreateQuestion\x12!.management.CreateQuestionRequest\x1a\".management.CreateQuestionResponse\x12J\n" +
+	"\fGetQuestions\x12\x18.management.EventRequest\x1a .management.GetQuestionsResponse\x12N\n" +
+	"\x0eDeleteQuestion\x12!.management.DeleteQuestionRequest\x1a\x19.management.EmptyResponse\x12L\n" +
+	"\rExportAnswers\x12\x18.management.EventRequest\x1a!.management.ExportAnswersResponse\x12C\n" +
+	"\fCreateInvite\x12\x1f.management.CreateInviteRequest\x1a\x12.management.Invite\x12F\n" +
+	"\n" +
+	"GetInvites\x12\x18.management.EventRequest\x1a\x1e.management.GetInvitesResponse\x12J\n" +
+	"\fRevokeInvite\x12\x1f.management.RevokeInviteRequest\x1a\x19.management.EmptyResponse\x12L\n" +
+	"\rGetOrganizers\x12\x18.management.EventRequest\x1a!.management.GetOrganizersResponse\x12F\n" +
+	"\fAddOrganizer\x12\x1f.management.AddOrganizerRequest\x1a\x15.management.Organizer\x12J\n" +
+	"\x0fRemoveOrganizer\x12\x1c.management.OrganizerRequest\x1a\x19.management.EmptyResponse\x12T\n" +
+	"\x11TransferOwnership\x12$.management.TransferOwnershipRequest\x1a\x19.management.EmptyResponseB=Z;github.com/Estriper0/EventService/api/management;managementb\x06proto3"
+
+var (
+	file_api_management_management_proto_rawDescOnce sync.Once
+	file_api_management_management_proto_rawDescData []byte
+)
+
+func file_api_management_management_proto_rawDescGZIP() []byte {
+	file_api_management_management_proto_rawDescOnce.Do(func() {
+		file_api_management_management_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_management_management_proto_rawDesc), len(file_api_management_management_proto_rawDesc)))
+	})
+	return file_api_management_management_proto_rawDescData
+}
+
+var file_api_management_management_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_api_management_management_proto_goTypes = []any{
+	(*EmptyResponse)(nil),               // 0: management.EmptyResponse
+	(*EventRequest)(nil),                // 1: management.EventRequest
+	(*EventResponse)(nil),               // 2: management.EventResponse
+	(*Settings)(nil),                    // 3: management.Settings
+	(*UpdateSettingsRequest)(nil),       // 4: management.UpdateSettingsRequest
+	(*RegistrationRequest)(nil),         // 5: management.RegistrationRequest
+	(*Registration)(nil),                // 6: management.Registration
+	(*RegistrationsResponse)(nil),       // 7: management.RegistrationsResponse
+	(*FieldChange)(nil),                 // 8: management.FieldChange
+	(*Revision)(nil),                    // 9: management.Revision
+	(*GetHistoryResponse)(nil),          // 10: management.GetHistoryResponse
+	(*GetRevisionRequest)(nil),          // 11: management.GetRevisionRequest
+	(*GetTicketRequest)(nil),            // 12: management.GetTicketRequest
+	(*Ticket)(nil),                      // 13: management.Ticket
+	(*CheckInRequest)(nil),              // 14: management.CheckInRequest
+	(*Attendance)(nil),                  // 15: management.Attendance
+	(*TicketType)(nil),                  // 16: management.TicketType
+	(*CreateTicketTypeRequest)(nil),     // 17: management.CreateTicketTypeRequest
+	(*CreateTicketTypeResponse)(nil),    // 18: management.CreateTicketTypeResponse
+	(*GetTicketTypesResponse)(nil),      // 19: management.GetTicketTypesResponse
+	(*ChangeGuestsRequest)(nil),         // 20: management.ChangeGuestsRequest
+	(*RegisterGroupRequest)(nil),        // 21: management.RegisterGroupRequest
+	(*GroupOutcome)(nil),                // 22: management.GroupOutcome
+	(*RegisterGroupResponse)(nil),       // 23: management.RegisterGroupResponse
+	(*TransferRegistrationRequest)(nil), // 24: management.TransferRegistrationRequest
+	(*Question)(nil),                    // 25: management.Question
+	(*Answer)(nil),                      // 26: management.Answer
+	(*CreateQuestionRequest)(nil),       // 27: management.CreateQuestionRequest
+	(*CreateQuestionResponse)(nil),      // 28: management.CreateQuestionResponse
+	(*GetQuestionsResponse)(nil),        // 29: management.GetQuestionsResponse
+	(*DeleteQuestionRequest)(nil),       // 30: management.DeleteQuestionRequest
+	(*ExportAnswersResponse)(nil),       // 31: management.ExportAnswersResponse
+	(*Invite)(nil),                      // 32: management.Invite
+	(*CreateInviteRequest)(nil),         // 33: management.CreateInviteRequest
+	(*GetInvitesResponse)(nil),          // 34: management.GetInvitesResponse
+	(*RevokeInviteRequest)(nil),         // 35: management.RevokeInviteRequest
+	(*Organizer)(nil),                   // 36: management.Organizer
+	(*GetOrganizersResponse)(nil),       // 37: management.GetOrganizersResponse
+	(*AddOrganizerRequest)(nil),         // 38: management.AddOrganizerRequest
+	(*OrganizerRequest)(nil),            // 39: management.OrganizerRequest
+	(*TransferOwnershipRequest)(nil),    // 40: management.TransferOwnershipRequest
+	(*timestamppb.Timestamp)(nil),       // 41: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 42: google.protobuf.Duration
+}
+var file_api_management_management_proto_depIdxs = []int32{
+	41, // 0: management.EventResponse.start_date:type_name -> google.protobuf.Timestamp
+	3,  // 1: management.EventResponse.settings:type_name -> management.Settings
+	41, // 2: management.Settings.registration_opens_at:type_name -> google.protobuf.Timestamp
+	41, // 3: management.Settings.registration_closes_at:type_name -> google.protobuf.Timestamp
+	42, // 4: management.Settings.cancellation_cutoff:type_name -> google.protobuf.Duration
+	41, // 5: management.UpdateSettingsRequest.registration_opens_at:type_name -> google.protobuf.Timestamp
+	41, // 6: management.UpdateSettingsRequest.registration_closes_at:type_name -> google.protobuf.Timestamp
+	42, // 7: management.UpdateSettingsRequest.cancellation_cutoff:type_name -> google.protobuf.Duration
+	41, // 8: management.Registration.registered_at:type_name -> google.protobuf.Timestamp
+	41, // 9: management.Registration.cancelled_at:type_name -> google.protobuf.Timestamp
+	41, // 10: management.Registration.checked_in_at:type_name -> google.protobuf.Timestamp
+	26, // 11: management.Registration.answers:type_name -> management.Answer
+	6,  // 12: management.RegistrationsResponse.registrations:type_name -> management.Registration
+	41, // 13: management.Revision.changed_at:type_name -> google.protobuf.Timestamp
+	8,  // 14: management.Revision.changes:type_name -> management.FieldChange
+	2,  // 15: management.Revision.snapshot:type_name -> management.EventResponse
+	9,  // 16: management.GetHistoryResponse.revisions:type_name -> management.Revision
+	41, // 17: management.TicketType.sale_starts_at:type_name -> google.protobuf.Timestamp
+	41, // 18: management.TicketType.sale_ends_at:type_name -> google.protobuf.Timestamp
+	41, // 19: management.CreateTicketTypeRequest.sale_starts_at:type_name -> google.protobuf.Timestamp
+	41, // 20: management.CreateTicketTypeRequest.sale_ends_at:type_name -> google.protobuf.Timestamp
+	16, // 21: management.GetTicketTypesResponse.ticket_types:type_name -> management.TicketType
+	22, // 22: management.RegisterGroupResponse.outcomes:type_name -> management.GroupOutcome
+	25, // 23: management.GetQuestionsResponse.questions:type_name -> management.Question
+	25, // 24: management.ExportAnswersResponse.questions:type_name -> management.Question
+	6,  // 25: management.ExportAnswersResponse.registrations:type_name -> management.Registration
+	41, // 26: management.Invite.created_at:type_name -> google.protobuf.Timestamp
+	41, // 27: management.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	32, // 28: management.GetInvitesResponse.invites:type_name -> management.Invite
+	41, // 29: management.Organizer.added_at:type_name -> google.protobuf.Timestamp
+	36, // 30: management.GetOrganizersResponse.organizers:type_name -> management.Organizer
+	1,  // 31: management.EventManagement.GetHistory:input_type -> management.EventRequest
+	11, // 32: management.EventManagement.GetRevision:input_type -> management.GetRevisionRequest
+	1,  // 33: management.EventManagement.Restore:input_type -> management.EventRequest
+	1,  // 34: management.EventManagement.GetSettings:input_type -> management.EventRequest
+	4,  // 35: management.EventManagement.UpdateSettings:input_type -> management.UpdateSettingsRequest
+	1,  // 36: management.EventManagement.GetPending:input_type -> management.EventRequest
+	5,  // 37: management.EventManagement.Approve:input_type -> management.RegistrationRequest
+	5,  // 38: management.EventManagement.Reject:input_type -> management.RegistrationRequest
+	5,  // 39: management.EventManagement.GetRegistration:input_type -> management.RegistrationRequest
+	12, // 40: management.EventManagement.GetTicket:input_type -> management.GetTicketRequest
+	14, // 41: management.EventManagement.CheckIn:input_type -> management.CheckInRequest
+	1,  // 42: management.EventManagement.GetAttendance:input_type -> management.EventRequest
+	17, // 43: management.EventManagement.CreateTicketType:input_type -> management.CreateTicketTypeRequest
+	1,  // 44: management.EventManagement.GetTicketTypes:input_type -> management.EventRequest
+	20, // 45: management.EventManagement.ChangeGuests:input_type -> management.ChangeGuestsRequest
+	21, // 46: management.EventManagement.RegisterGroup:input_type -> management.RegisterGroupRequest
+	24, // 47: management.EventManagement.TransferRegistration:input_type -> management.TransferRegistrationRequest
+	27, // 48: management.EventManagement.CreateQuestion:input_type -> management.CreateQuestionRequest
+	1,  // 49: management.EventManagement.GetQuestions:input_type -> management.EventRequest
+	30, // 50: management.EventManagement.DeleteQuestion:input_type -> management.DeleteQuestionRequest
+	1,  // 51: management.EventManagement.ExportAnswers:input_type -> management.EventRequest
+	33, // 52: management.EventManagement.CreateInvite:input_type -> management.CreateInviteRequest
+	1,  // 53: management.EventManagement.GetInvites:input_type -> management.EventRequest
+	35, // 54: management.EventManagement.RevokeInvite:input_type -> management.RevokeInviteRequest
+	1,  // 55: management.EventManagement.GetOrganizers:input_type -> management.EventRequest
+	38, // 56: management.EventManagement.AddOrganizer:input_type -> management.AddOrganizerRequest
+	39, // 57: management.EventManagement.RemoveOrganizer:input_type -> management.OrganizerRequest
+	40, // 58: management.EventManagement.TransferOwnership:input_type -> management.TransferOwnershipRequest
+	10, // 59: management.EventManagement.GetHistory:output_type -> management.GetHistoryResponse
+	2,  // 60: management.EventManagement.GetRevision:output_type -> management.EventResponse
+	0,  // 61: management.EventManagement.Restore:output_type -> management.EmptyResponse
+	3,  // 62: management.EventManagement.GetSettings:output_type -> management.Settings
+	0,  // 63: management.EventManagement.UpdateSettings:output_type -> management.EmptyResponse
+	7,  // 64: management.EventManagement.GetPending:output_type -> management.RegistrationsResponse
+	0,  // 65: management.EventManagement.Approve:output_type -> management.EmptyResponse
+	0,  // 66: management.EventManagement.Reject:output_type -> management.EmptyResponse
+	6,  // 67: management.EventManagement.GetRegistration:output_type -> management.Registration
+	13, // 68: management.EventManagement.GetTicket:output_type -> management.Ticket
+	6,  // 69: management.EventManagement.CheckIn:output_type -> management.Registration
+	15, // 70: management.EventManagement.GetAttendance:output_type -> management.Attendance
+	18, // 71: management.EventManagement.CreateTicketType:output_type -> management.CreateTicketTypeResponse
+	19, // 72: management.EventManagement.GetTicketTypes:output_type -> management.GetTicketTypesResponse
+	0,  // 73: management.EventManagement.ChangeGuests:output_type -> management.EmptyResponse
+	23, // 74: management.EventManagement.RegisterGroup:output_type -> management.RegisterGroupResponse
+	0,  // 75: management.EventManagement.TransferRegistration:output_type -> management.EmptyResponse
+	28, // 76: management.EventManagement.CreateQuestion:output_type -> management.CreateQuestionResponse
+	29, // 77: management.EventManagement.GetQuestions:output_type -> management.GetQuestionsResponse
+	0,  // 78: management.EventManagement.DeleteQuestion:output_type -> management.EmptyResponse
+	31, // 79: management.EventManagement.ExportAnswers:output_type -> management.ExportAnswersResponse
+	32, // 80: management.EventManagement.CreateInvite:output_type -> management.Invite
+	34, // 81: management.EventManagement.GetInvites:output_type -> management.GetInvitesResponse
+	0,  // 82: management.EventManagement.RevokeInvite:output_type -> management.EmptyResponse
+	37, // 83: management.EventManagement.GetOrganizers:output_type -> management.GetOrganizersResponse
+	36, // 84: management.EventManagement.AddOrganizer:output_type -> management.Organizer
+	0,  // 85: management.EventManagement.RemoveOrganizer:output_type -> management.EmptyResponse
+	0,  // 86: management.EventManagement.TransferOwnership:output_type -> management.EmptyResponse
+	59, // [59:87] is the sub-list for method output_type
+	31, // [31:59] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_api_management_management_proto_init() }
+func file_api_management_management_proto_init() {
+	if File_api_management_management_proto != nil {
+		return
+	}
+	file_api_management_management_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_management_management_proto_rawDesc), len(file_api_management_management_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_management_management_proto_goTypes,
+		DependencyIndexes: file_api_management_management_proto_depIdxs,
+		MessageInfos:      file_api_management_management_proto_msgTypes,
+	}.Build()
+	File_api_management_management_proto = out.File
+	file_api_management_management_proto_goTypes = nil
+	file_api_management_management_proto_depIdxs = nil
+}
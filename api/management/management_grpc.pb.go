@@ -0,0 +1,1153 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: api/management/management.proto
+
+package management
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EventManagement_GetHistory_FullMethodName           = "/management.EventManagement/GetHistory"
+	EventManagement_GetRevision_FullMethodName          = "/management.EventManagement/GetRevision"
+	EventManagement_Restore_FullMethodName              = "/management.EventManagement/Restore"
+	EventManagement_GetSettings_FullMethodName          = "/management.EventManagement/GetSettings"
+	EventManagement_UpdateSettings_FullMethodName       = "/management.EventManagement/UpdateSettings"
+	EventManagement_GetPending_FullMethodName           = "/management.EventManagement/GetPending"
+	EventManagement_Approve_FullMethodName              = "/management.EventManagement/Approve"
+	EventManagement_Reject_FullMethodName               = "/management.EventManagement/Reject"
+	EventManagement_GetRegistration_FullMethodName      = "/management.EventManagement/GetRegistration"
+	EventManagement_GetTicket_FullMethodName            = "/management.EventManagement/GetTicket"
+	EventManagement_CheckIn_FullMethodName              = "/management.EventManagement/CheckIn"
+	EventManagement_GetAttendance_FullMethodName        = "/management.EventManagement/GetAttendance"
+	EventManagement_CreateTicketType_FullMethodName     = "/management.EventManagement/CreateTicketType"
+	EventManagement_GetTicketTypes_FullMethodName       = "/management.EventManagement/GetTicketTypes"
+	EventManagement_ChangeGuests_FullMethodName         = "/management.EventManagement/ChangeGuests"
+	EventManagement_RegisterGroup_FullMethodName        = "/management.EventManagement/RegisterGroup"
+	EventManagement_TransferRegistration_FullMethodName = "/management.EventManagement/TransferRegistration"
+	EventManagement_CreateQuestion_FullMethodName       = "/management.EventManagement/CreateQuestion"
+	EventManagement_GetQuestions_FullMethodName         = "/management.EventManagement/GetQuestions"
+	EventManagement_DeleteQuestion_FullMethodName       = "/management.EventManagement/DeleteQuestion"
+	EventManagement_ExportAnswers_FullMethodName        = "/management.EventManagement/ExportAnswers"
+	EventManagement_CreateInvite_FullMethodName         = "/management.EventManagement/CreateInvite"
+	EventManagement_GetInvites_FullMethodName           = "/management.EventManagement/GetInvites"
+	EventManagement_RevokeInvite_FullMethodName         = "/management.EventManagement/RevokeInvite"
+	EventManagement_GetOrganizers_FullMethodName        = "/management.EventManagement/GetOrganizers"
+	EventManagement_AddOrganizer_FullMethodName         = "/management.EventManagement/AddOrganizer"
+	EventManagement_RemoveOrganizer_FullMethodName      = "/management.EventManagement/RemoveOrganizer"
+	EventManagement_TransferOwnership_FullMethodName    = "/management.EventManagement/TransferOwnership"
+)
+
+// EventManagementClient is the client API for EventManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EventManagement serves the features of events that the Event service of
+// github.com/Estriper0/protobuf has no RPCs for.
+type EventManagementClient interface {
+	GetHistory(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*EventResponse, error)
+	Restore(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetSettings(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*Settings, error)
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetPending(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*RegistrationsResponse, error)
+	Approve(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Reject(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetRegistration(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*Registration, error)
+	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Registration, error)
+	GetAttendance(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*Attendance, error)
+	CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error)
+	GetTicketTypes(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetTicketTypesResponse, error)
+	ChangeGuests(ctx context.Context, in *ChangeGuestsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	RegisterGroup(ctx context.Context, in *RegisterGroupRequest, opts ...grpc.CallOption) (*RegisterGroupResponse, error)
+	TransferRegistration(ctx context.Context, in *TransferRegistrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error)
+	GetQuestions(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetQuestionsResponse, error)
+	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ExportAnswers(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*ExportAnswersResponse, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+	GetInvites(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetOrganizers(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetOrganizersResponse, error)
+	AddOrganizer(ctx context.Context, in *AddOrganizerRequest, opts ...grpc.CallOption) (*Organizer, error)
+	RemoveOrganizer(ctx context.Context, in *OrganizerRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type eventManagementClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventManagementClient(cc grpc.ClientConnInterface) EventManagementClient {
+	return &eventManagementClient{cc}
+}
+
+func (c *eventManagementClient) GetHistory(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, EventManagement_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*EventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventResponse)
+	err := c.cc.Invoke(ctx, EventManagement_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) Restore(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetSettings(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*Settings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Settings)
+	err := c.cc.Invoke(ctx, EventManagement_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_UpdateSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetPending(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*RegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegistrationsResponse)
+	err := c.cc.Invoke(ctx, EventManagement_GetPending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) Approve(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_Approve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) Reject(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_Reject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetRegistration(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*Registration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, EventManagement_GetRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, EventManagement_GetTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Registration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, EventManagement_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetAttendance(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*Attendance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attendance)
+	err := c.cc.Invoke(ctx, EventManagement_GetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventManagement_CreateTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetTicketTypes(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetTicketTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketTypesResponse)
+	err := c.cc.Invoke(ctx, EventManagement_GetTicketTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) ChangeGuests(ctx context.Context, in *ChangeGuestsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_ChangeGuests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) RegisterGroup(ctx context.Context, in *RegisterGroupRequest, opts ...grpc.CallOption) (*RegisterGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterGroupResponse)
+	err := c.cc.Invoke(ctx, EventManagement_RegisterGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) TransferRegistration(ctx context.Context, in *TransferRegistrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_TransferRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuestionResponse)
+	err := c.cc.Invoke(ctx, EventManagement_CreateQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetQuestions(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionsResponse)
+	err := c.cc.Invoke(ctx, EventManagement_GetQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_DeleteQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) ExportAnswers(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*ExportAnswersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAnswersResponse)
+	err := c.cc.Invoke(ctx, EventManagement_ExportAnswers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, EventManagement_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetInvites(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvitesResponse)
+	err := c.cc.Invoke(ctx, EventManagement_GetInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) GetOrganizers(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*GetOrganizersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizersResponse)
+	err := c.cc.Invoke(ctx, EventManagement_GetOrganizers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) AddOrganizer(ctx context.Context, in *AddOrganizerRequest, opts ...grpc.CallOption) (*Organizer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organizer)
+	err := c.cc.Invoke(ctx, EventManagement_AddOrganizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) RemoveOrganizer(ctx context.Context, in *OrganizerRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_RemoveOrganizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventManagementClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, EventManagement_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventManagementServer is the server API for EventManagement service.
+// All implementations must embed UnimplementedEventManagementServer
+// for forward compatibility.
+//
+// EventManagement serves the features of events that the Event service of
+// github.com/Estriper0/protobuf has no RPCs for.
+type EventManagementServer interface {
+	GetHistory(context.Context, *EventRequest) (*GetHistoryResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*EventResponse, error)
+	Restore(context.Context, *EventRequest) (*EmptyResponse, error)
+	GetSettings(context.Context, *EventRequest) (*Settings, error)
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*EmptyResponse, error)
+	GetPending(context.Context, *EventRequest) (*RegistrationsResponse, error)
+	Approve(context.Context, *RegistrationRequest) (*EmptyResponse, error)
+	Reject(context.Context, *RegistrationRequest) (*EmptyResponse, error)
+	GetRegistration(context.Context, *RegistrationRequest) (*Registration, error)
+	GetTicket(context.Context, *GetTicketRequest) (*Ticket, error)
+	CheckIn(context.Context, *CheckInRequest) (*Registration, error)
+	GetAttendance(context.Context, *EventRequest) (*Attendance, error)
+	CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error)
+	GetTicketTypes(context.Context, *EventRequest) (*GetTicketTypesResponse, error)
+	ChangeGuests(context.Context, *ChangeGuestsRequest) (*EmptyResponse, error)
+	RegisterGroup(context.Context, *RegisterGroupRequest) (*RegisterGroupResponse, error)
+	TransferRegistration(context.Context, *TransferRegistrationRequest) (*EmptyResponse, error)
+	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
+	GetQuestions(context.Context, *EventRequest) (*GetQuestionsResponse, error)
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*EmptyResponse, error)
+	ExportAnswers(context.Context, *EventRequest) (*ExportAnswersResponse, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*Invite, error)
+	GetInvites(context.Context, *EventRequest) (*GetInvitesResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*EmptyResponse, error)
+	GetOrganizers(context.Context, *EventRequest) (*GetOrganizersResponse, error)
+	AddOrganizer(context.Context, *AddOrganizerRequest) (*Organizer, error)
+	RemoveOrganizer(context.Context, *OrganizerRequest) (*EmptyResponse, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*EmptyResponse, error)
+	mustEmbedUnimplementedEventManagementServer()
+}
+
+// UnimplementedEventManagementServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventManagementServer struct{}
+
+func (UnimplementedEventManagementServer) GetHistory(context.Context, *EventRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedEventManagementServer) GetRevision(context.Context, *GetRevisionRequest) (*EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedEventManagementServer) Restore(context.Context, *EventRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedEventManagementServer) GetSettings(context.Context, *EventRequest) (*Settings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedEventManagementServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedEventManagementServer) GetPending(context.Context, *EventRequest) (*RegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPending not implemented")
+}
+func (UnimplementedEventManagementServer) Approve(context.Context, *RegistrationRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedEventManagementServer) Reject(context.Context, *RegistrationRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reject not implemented")
+}
+func (UnimplementedEventManagementServer) GetRegistration(context.Context, *RegistrationRequest) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistration not implemented")
+}
+func (UnimplementedEventManagementServer) GetTicket(context.Context, *GetTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedEventManagementServer) CheckIn(context.Context, *CheckInRequest) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedEventManagementServer) GetAttendance(context.Context, *EventRequest) (*Attendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+}
+func (UnimplementedEventManagementServer) CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTicketType not implemented")
+}
+func (UnimplementedEventManagementServer) GetTicketTypes(context.Context, *EventRequest) (*GetTicketTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketTypes not implemented")
+}
+func (UnimplementedEventManagementServer) ChangeGuests(context.Context, *ChangeGuestsRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeGuests not implemented")
+}
+func (UnimplementedEventManagementServer) RegisterGroup(context.Context, *RegisterGroupRequest) (*RegisterGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGroup not implemented")
+}
+func (UnimplementedEventManagementServer) TransferRegistration(context.Context, *TransferRegistrationRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRegistration not implemented")
+}
+func (UnimplementedEventManagementServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (UnimplementedEventManagementServer) GetQuestions(context.Context, *EventRequest) (*GetQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestions not implemented")
+}
+func (UnimplementedEventManagementServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedEventManagementServer) ExportAnswers(context.Context, *EventRequest) (*ExportAnswersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAnswers not implemented")
+}
+func (UnimplementedEventManagementServer) CreateInvite(context.Context, *CreateInviteRequest) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedEventManagementServer) GetInvites(context.Context, *EventRequest) (*GetInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvites not implemented")
+}
+func (UnimplementedEventManagementServer) RevokeInvite(context.Context, *RevokeInviteRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedEventManagementServer) GetOrganizers(context.Context, *EventRequest) (*GetOrganizersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizers not implemented")
+}
+func (UnimplementedEventManagementServer) AddOrganizer(context.Context, *AddOrganizerRequest) (*Organizer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizer not implemented")
+}
+func (UnimplementedEventManagementServer) RemoveOrganizer(context.Context, *OrganizerRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizer not implemented")
+}
+func (UnimplementedEventManagementServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedEventManagementServer) mustEmbedUnimplementedEventManagementServer() {}
+func (UnimplementedEventManagementServer) testEmbeddedByValue()                         {}
+
+// UnsafeEventManagementServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventManagementServer will
+// result in compilation errors.
+type UnsafeEventManagementServer interface {
+	mustEmbedUnimplementedEventManagementServer()
+}
+
+func RegisterEventManagementServer(s grpc.ServiceRegistrar, srv EventManagementServer) {
+	// If the following call pancis, it indicates UnimplementedEventManagementServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventManagement_ServiceDesc, srv)
+}
+
+func _EventManagement_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetHistory(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).Restore(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetSettings(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_UpdateSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetPending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetPending(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).Approve(ctx, req.(*RegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_Reject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).Reject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_Reject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).Reject(ctx, req.(*RegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetRegistration(ctx, req.(*RegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetTicket(ctx, req.(*GetTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetAttendance(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_CreateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).CreateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_CreateTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).CreateTicketType(ctx, req.(*CreateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetTicketTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetTicketTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetTicketTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetTicketTypes(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_ChangeGuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeGuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).ChangeGuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_ChangeGuests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).ChangeGuests(ctx, req.(*ChangeGuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_RegisterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).RegisterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_RegisterGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).RegisterGroup(ctx, req.(*RegisterGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_TransferRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).TransferRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_TransferRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).TransferRegistration(ctx, req.(*TransferRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).CreateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_CreateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetQuestions(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_DeleteQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_ExportAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).ExportAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_ExportAnswers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).ExportAnswers(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetInvites(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_GetOrganizers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).GetOrganizers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_GetOrganizers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).GetOrganizers(ctx, req.(*EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_AddOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).AddOrganizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_AddOrganizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).AddOrganizer(ctx, req.(*AddOrganizerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_RemoveOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).RemoveOrganizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_RemoveOrganizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).RemoveOrganizer(ctx, req.(*OrganizerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventManagement_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventManagementServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventManagement_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventManagementServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventManagement_ServiceDesc is the grpc.ServiceDesc for EventManagement service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventManagement_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "management.EventManagement",
+	HandlerType: (*EventManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHistory",
+			Handler:    _EventManagement_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _EventManagement_GetRevision_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _EventManagement_Restore_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _EventManagement_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _EventManagement_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "GetPending",
+			Handler:    _EventManagement_GetPending_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _EventManagement_Approve_Handler,
+		},
+		{
+			MethodName: "Reject",
+			Handler:    _EventManagement_Reject_Handler,
+		},
+		{
+			MethodName: "GetRegistration",
+			Handler:    _EventManagement_GetRegistration_Handler,
+		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _EventManagement_GetTicket_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _EventManagement_CheckIn_Handler,
+		},
+		{
+			MethodName: "GetAttendance",
+			Handler:    _EventManagement_GetAttendance_Handler,
+		},
+		{
+			MethodName: "CreateTicketType",
+			Handler:    _EventManagement_CreateTicketType_Handler,
+		},
+		{
+			MethodName: "GetTicketTypes",
+			Handler:    _EventManagement_GetTicketTypes_Handler,
+		},
+		{
+			MethodName: "ChangeGuests",
+			Handler:    _EventManagement_ChangeGuests_Handler,
+		},
+		{
+			MethodName: "RegisterGroup",
+			Handler:    _EventManagement_RegisterGroup_Handler,
+		},
+		{
+			MethodName: "TransferRegistration",
+			Handler:    _EventManagement_TransferRegistration_Handler,
+		},
+		{
+			MethodName: "CreateQuestion",
+			Handler:    _EventManagement_CreateQuestion_Handler,
+		},
+		{
+			MethodName: "GetQuestions",
+			Handler:    _EventManagement_GetQuestions_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _EventManagement_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "ExportAnswers",
+			Handler:    _EventManagement_ExportAnswers_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _EventManagement_CreateInvite_Handler,
+		},
+		{
+			MethodName: "GetInvites",
+			Handler:    _EventManagement_GetInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _EventManagement_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "GetOrganizers",
+			Handler:    _EventManagement_GetOrganizers_Handler,
+		},
+		{
+			MethodName: "AddOrganizer",
+			Handler:    _EventManagement_AddOrganizer_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizer",
+			Handler:    _EventManagement_RemoveOrganizer_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _EventManagement_TransferOwnership_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/management/management.proto",
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/certs"
+	"github.com/Estriper0/EventService/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func main() {
+	config := config.New()
+
+	creds := insecure.NewCredentials()
+	if config.TLS.Enabled {
+		tlsConfig, err := probeTLS(&config.TLS)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("localhost:%d", config.Port),
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		fmt.Println(resp.Status.String())
+		os.Exit(1)
+	}
+}
+
+// probeTLS verifies the server against the probe CA and, under mutual TLS,
+// presents the client certificate of the probe.
+func probeTLS(cfg *config.TLS) (*tls.Config, error) {
+	tlsConfig := &tls.Config{ServerName: cfg.Probe.ServerName}
+
+	if cfg.Probe.CAFile != "" {
+		data, err := os.ReadFile(cfg.Probe.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, certs.ErrInvalidCA
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCAFile != "" {
+		if cfg.Probe.CertFile == "" || cfg.Probe.KeyFile == "" {
+			return nil, errors.New("mutual TLS requires tls.probe.cert_file and tls.probe.key_file")
+		}
+		cert, err := tls.LoadX509KeyPair(cfg.Probe.CertFile, cfg.Probe.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
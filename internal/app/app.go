@@ -1,56 +1,111 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 	"log/slog"
 
 	rd "github.com/Estriper0/EventService/internal/cache/redis"
 	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/health"
+	"github.com/Estriper0/EventService/internal/metrics"
+	"github.com/Estriper0/EventService/internal/purge"
+	"github.com/Estriper0/EventService/internal/ratelimit"
+	"github.com/Estriper0/EventService/internal/ratelimit/memory"
+	rl "github.com/Estriper0/EventService/internal/ratelimit/redis"
 	event_repo "github.com/Estriper0/EventService/internal/repositories/event"
+	eventhistory "github.com/Estriper0/EventService/internal/repositories/event_history"
 	eventuser "github.com/Estriper0/EventService/internal/repositories/event_user"
+	"github.com/Estriper0/EventService/internal/repositories/invite"
+	"github.com/Estriper0/EventService/internal/repositories/organizer"
+	"github.com/Estriper0/EventService/internal/repositories/question"
+	tickettype "github.com/Estriper0/EventService/internal/repositories/ticket_type"
 	"github.com/Estriper0/EventService/internal/server"
 	event_service "github.com/Estriper0/EventService/internal/service/event"
+	"github.com/Estriper0/EventService/internal/tracing"
 	db "github.com/Estriper0/EventService/pkg/database"
 	"github.com/redis/go-redis/v9"
 )
 
 type App struct {
-	logger     *slog.Logger
-	config     *config.Config
-	grpcServer *server.GRPCServer
-	db         *sql.DB
+	logger        *slog.Logger
+	config        *config.Config
+	grpcServer    *server.GRPCServer
+	metricsServer *server.MetricsServer
+	purgeJob      *purge.Job
+	db            *sql.DB
+	shutdownTrace func(context.Context) error
 }
 
 func New(
 	logger *slog.Logger,
 	config *config.Config,
 ) *App {
+	shutdownTrace, err := tracing.New(context.Background(), &config.Tracing)
+	if err != nil {
+		panic(err)
+	}
+
 	db := db.GetDB(&config.DB)
+	metrics.RegisterDB(db, config.DB.DbName)
 
 	eventRepo := event_repo.New(db)
 	eventUserRepo := eventuser.New(db)
+	eventHistoryRepo := eventhistory.New(db)
+	ticketTypeRepo := tickettype.New(db)
+	questionRepo := question.New(db)
+	inviteRepo := invite.New(db)
+	organizerRepo := organizer.New(db)
 	redisClient := redis.NewClient(&redis.Options{Addr: config.Redis.Addr, Password: config.Redis.Password})
 	cache := rd.New(redisClient)
-	eventService := event_service.New(eventRepo, eventUserRepo, cache, logger, config)
-	grpcServer := server.New(logger, config, eventService)
+	if config.Tickets.Secret == "" {
+		logger.Warn("Ticket secret is not set, tickets are disabled")
+	}
+	eventService := event_service.New(eventRepo, eventUserRepo, eventHistoryRepo, ticketTypeRepo, questionRepo, inviteRepo, organizerRepo, cache, logger, config)
+	limiter := ratelimit.WithFallback(rl.New(redisClient), memory.New(), logger)
+	grpcServer := server.New(
+		logger,
+		config,
+		eventService,
+		limiter,
+		health.Check{Name: "postgres", Ping: db.PingContext},
+		health.Check{Name: "redis", Ping: func(ctx context.Context) error {
+			return redisClient.Ping(ctx).Err()
+		}},
+	)
+	metricsServer := server.NewMetrics(logger, config)
+	purgeJob := purge.New(eventRepo, logger, &config.Retention)
 
 	return &App{
-		logger:     logger,
-		config:     config,
-		grpcServer: grpcServer,
-		db:         db,
+		logger:        logger,
+		config:        config,
+		grpcServer:    grpcServer,
+		metricsServer: metricsServer,
+		purgeJob:      purgeJob,
+		db:            db,
+		shutdownTrace: shutdownTrace,
 	}
 }
 
 func (a *App) Run() {
 	a.logger.Info("Start application")
 
+	go a.metricsServer.Run()
+	go a.purgeJob.Run()
 	a.grpcServer.Run()
 }
 
 func (a *App) Stop() {
 	a.grpcServer.Stop()
+	a.metricsServer.Stop()
+	a.purgeJob.Stop()
 	a.db.Close()
+	if err := a.shutdownTrace(context.Background()); err != nil {
+		a.logger.Error(
+			"Error stopping tracing",
+			slog.String("err", err.Error()),
+		)
+	}
 
 	a.logger.Info("Stop application")
 }
@@ -0,0 +1,30 @@
+package auth
+
+import "context"
+
+const (
+	UserIdKey = "x-user-id"
+	RoleKey   = "x-user-role"
+
+	RoleAdmin = "admin"
+)
+
+type Caller struct {
+	UserId string
+	Role   string
+}
+
+func (c *Caller) IsAdmin() bool {
+	return c.Role == RoleAdmin
+}
+
+type callerKey struct{}
+
+func WithCaller(ctx context.Context, caller *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func CallerFromContext(ctx context.Context) (*Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(*Caller)
+	return caller, ok && caller != nil
+}
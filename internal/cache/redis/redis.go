@@ -7,10 +7,16 @@ import (
 
 	"github.com/Estriper0/EventService/internal/cache"
 	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/tracing"
 	"github.com/redis/go-redis/v9"
 	"github.com/vmihailenco/msgpack/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/cache/redis")
+
 type redisCache struct {
 	client *redis.Client
 }
@@ -22,13 +28,25 @@ func New(client *redis.Client) *redisCache {
 }
 
 func (r *redisCache) Del(ctx context.Context, key string) error {
-	return r.client.Del(ctx, key).Err()
+	ctx, span := tracer.Start(ctx, "Cache.Del", spanAttrs(key))
+	defer span.End()
+
+	err := r.client.Del(ctx, key).Err()
+	if err != nil {
+		tracing.RecordError(span, err)
+	}
+	return err
 }
 
 func (r *redisCache) GetEvent(ctx context.Context, id int) (*models.EventResponse, error) {
-	data, err := r.client.Get(ctx, "event:"+strconv.Itoa(id)).Bytes()
+	key := "event:" + strconv.Itoa(id)
+	ctx, span := tracer.Start(ctx, "Cache.GetEvent", spanAttrs(key))
+	defer span.End()
+
+	data, err := r.client.Get(ctx, key).Bytes()
 
 	if err != redis.Nil && err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 
@@ -36,25 +54,41 @@ func (r *redisCache) GetEvent(ctx context.Context, id int) (*models.EventRespons
 		event := &models.EventResponse{}
 		err = msgpack.Unmarshal(data, event)
 		if err == nil {
+			span.SetAttributes(attribute.Bool("cache.hit", true))
 			return event, nil
 		} else {
+			tracing.RecordError(span, err)
 			return nil, err
 		}
 	}
 
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 	return nil, cache.ErrNotFound
 }
 
 func (r *redisCache) SetEvent(ctx context.Context, event *models.EventResponse, ttl time.Duration) error {
+	key := "event:" + strconv.Itoa(event.Id)
+	ctx, span := tracer.Start(ctx, "Cache.SetEvent", spanAttrs(key))
+	defer span.End()
+
 	data, err := msgpack.Marshal(event)
 	if err == nil {
-		err = r.client.Set(ctx, "event:"+strconv.Itoa(event.Id), data, ttl).Err()
+		err = r.client.Set(ctx, key, data, ttl).Err()
 		if err != nil {
+			tracing.RecordError(span, err)
 			return err
 		} else {
 			return nil
 		}
 	} else {
+		tracing.RecordError(span, err)
 		return err
 	}
 }
+
+func spanAttrs(key string) trace.SpanStartOption {
+	return trace.WithAttributes(
+		attribute.String("db.system", "redis"),
+		attribute.String("cache.key", key),
+	)
+}
@@ -0,0 +1,159 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+)
+
+var ErrInvalidCA = errors.New("no certificates found in the CA bundle")
+
+// Reloader keeps the server certificate and the client CA bundle in memory
+// and reloads them when the files change on disk, so rotated certificates
+// are picked up without a restart.
+type Reloader struct {
+	config  *config.TLS
+	logger  *slog.Logger
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	modTime time.Time
+	stop    chan struct{}
+	once    sync.Once
+}
+
+func New(config *config.TLS, logger *slog.Logger) (*Reloader, error) {
+	r := &Reloader{
+		config: config,
+		logger: logger,
+		stop:   make(chan struct{}),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// TLSConfig returns a server config that always uses the latest certificates.
+// Client certificates are required and verified when a CA bundle is configured.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   []string{"h2"},
+			}
+			if r.pool != nil {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = r.pool
+			}
+			return cfg, nil
+		},
+	}
+}
+
+func (r *Reloader) Run() {
+	ticker := time.NewTicker(r.config.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			modTime, err := r.lastModified()
+			if err != nil {
+				r.logger.Error(
+					"Error checking certificates",
+					slog.String("err", err.Error()),
+				)
+				continue
+			}
+			if !modTime.After(r.loadedAt()) {
+				continue
+			}
+			if err := r.load(); err != nil {
+				r.logger.Error(
+					"Error reloading certificates, keeping the previous ones",
+					slog.String("err", err.Error()),
+				)
+				continue
+			}
+			r.logger.Info("Certificates reloaded")
+		}
+	}
+}
+
+func (r *Reloader) Stop() {
+	r.once.Do(func() {
+		close(r.stop)
+	})
+}
+
+func (r *Reloader) load() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	var pool *x509.CertPool
+	if r.config.ClientCAFile != "" {
+		data, err := os.ReadFile(r.config.ClientCAFile)
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return ErrInvalidCA
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.pool = pool
+	r.modTime = modTime
+	return nil
+}
+
+func (r *Reloader) loadedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.modTime
+}
+
+// lastModified returns the latest modification time of the configured files.
+func (r *Reloader) lastModified() (time.Time, error) {
+	files := []string{r.config.CertFile, r.config.KeyFile}
+	if r.config.ClientCAFile != "" {
+		files = append(files, r.config.ClientCAFile)
+	}
+
+	var latest time.Time
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,158 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key signed by the CA.
+func (ca *testCA) issue(t *testing.T, serial int64, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCA(t)
+	cfg := &config.TLS{
+		Enabled:        true,
+		CertFile:       filepath.Join(dir, "server.crt"),
+		KeyFile:        filepath.Join(dir, "server.key"),
+		ClientCAFile:   filepath.Join(dir, "ca.crt"),
+		ReloadInterval: 10 * time.Millisecond,
+	}
+
+	start := time.Now().Add(-time.Minute)
+	serverCert, serverKey := ca.issue(t, 2, x509.ExtKeyUsageServerAuth)
+	writeFile(t, cfg.CertFile, serverCert, start)
+	writeFile(t, cfg.KeyFile, serverKey, start)
+	writeFile(t, cfg.ClientCAFile, ca.pem, start)
+
+	reloader, err := New(cfg, logger.GetLogger("test"))
+	require.NoError(t, err)
+	go reloader.Run()
+	defer reloader.Stop()
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(reloader.TLSConfig())))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(l)
+	defer server.Stop()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientPEM, clientKey := ca.issue(t, 3, x509.ExtKeyUsageClientAuth)
+	clientCert, err := tls.X509KeyPair(clientPEM, clientKey)
+	require.NoError(t, err)
+
+	// check calls the health service and returns the serial of the server certificate.
+	check := func(certificates []tls.Certificate) (*big.Int, error) {
+		var serial *big.Int
+		creds := credentials.NewTLS(&tls.Config{
+			RootCAs:      roots,
+			Certificates: certificates,
+			ServerName:   "localhost",
+			VerifyConnection: func(cs tls.ConnectionState) error {
+				serial = cs.PeerCertificates[0].SerialNumber
+				return nil
+			},
+		})
+		conn, err := grpc.NewClient(l.Addr().String(), grpc.WithTransportCredentials(creds))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		return serial, err
+	}
+
+	t.Run("client with certificate", func(t *testing.T) {
+		serial, err := check([]tls.Certificate{clientCert})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), serial.Int64())
+	})
+
+	t.Run("client without certificate", func(t *testing.T) {
+		_, err := check(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("rotated certificate", func(t *testing.T) {
+		serverCert, serverKey := ca.issue(t, 4, x509.ExtKeyUsageServerAuth)
+		writeFile(t, cfg.KeyFile, serverKey, time.Now())
+		writeFile(t, cfg.CertFile, serverCert, time.Now())
+
+		require.Eventually(t, func() bool {
+			serial, err := check([]tls.Certificate{clientCert})
+			return err == nil && serial.Int64() == 4
+		}, 5*time.Second, 20*time.Millisecond)
+	})
+}
@@ -9,10 +9,20 @@ import (
 )
 
 type Config struct {
-	Env   string   `mapstructure:"env"`
-	Port  int      `mapstructure:"port"`
-	DB    Database `mapstructure:"database"`
-	Redis Redis    `mapstructure:"redis"`
+	Env          string       `mapstructure:"env"`
+	Port         int          `mapstructure:"port"`
+	DB           Database     `mapstructure:"database"`
+	Redis        Redis        `mapstructure:"redis"`
+	RateLimit    RateLimit    `mapstructure:"rate_limit"`
+	Metrics      Metrics      `mapstructure:"metrics"`
+	Tracing      Tracing      `mapstructure:"tracing"`
+	Health       Health       `mapstructure:"health"`
+	TLS          TLS          `mapstructure:"tls"`
+	Deadlines    Deadlines    `mapstructure:"deadlines"`
+	LoadShed     LoadShedding `mapstructure:"load_shedding"`
+	Retention    Retention    `mapstructure:"retention"`
+	Tickets      Tickets      `mapstructure:"tickets"`
+	Registration Registration `mapstructure:"registration"`
 }
 
 type Database struct {
@@ -30,6 +40,99 @@ type Redis struct {
 	Password string        `mapstructure:"password"`
 }
 
+type Retention struct {
+	// RestorePeriod is how long a deleted event can be restored.
+	RestorePeriod time.Duration `mapstructure:"restore_period"`
+	// PurgeAfter is how long a deleted event is kept before it is removed permanently.
+	PurgeAfter    time.Duration `mapstructure:"purge_after"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
+// Registration holds the defaults for events without their own registration window
+// or cancellation cut-off.
+type Registration struct {
+	// ClosesBeforeStart is how long before the start of an event registration closes.
+	ClosesBeforeStart time.Duration `mapstructure:"closes_before_start"`
+	// CancellationCutoff is how long before the start of an event registrations can no longer be cancelled.
+	CancellationCutoff time.Duration `mapstructure:"cancellation_cutoff"`
+	// MaxGroupSize is the maximum number of users registered by one group registration.
+	MaxGroupSize int `mapstructure:"max_group_size"`
+}
+
+type Tickets struct {
+	// Secret signs the ticket tokens, tickets are disabled when it is empty.
+	Secret string `mapstructure:"secret"`
+	// QRSize is the size in pixels of the QR code rendered for a ticket.
+	QRSize int `mapstructure:"qr_size"`
+}
+
+type Deadlines struct {
+	Default time.Duration `mapstructure:"default"`
+	// Methods overrides Default per RPC, keyed by the lower-cased method name (e.g. "getall").
+	Methods map[string]time.Duration `mapstructure:"methods"`
+}
+
+type LoadShedding struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	InitialLimit  int           `mapstructure:"initial_limit"`
+	MinLimit      int           `mapstructure:"min_limit"`
+	MaxLimit      int           `mapstructure:"max_limit"`
+	TargetLatency time.Duration `mapstructure:"target_latency"`
+}
+
+type TLS struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+	// ClientCAFile enables mutual TLS: clients must present a certificate signed by one of these CAs.
+	ClientCAFile   string        `mapstructure:"client_ca_file"`
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+	// Probe is how the health-check probe connects to the server.
+	Probe TLSProbe `mapstructure:"probe"`
+}
+
+type TLSProbe struct {
+	// CAFile verifies the server certificate, the system roots are used when it is empty.
+	CAFile string `mapstructure:"ca_file"`
+	// ServerName must match the server certificate, as the probe dials localhost.
+	ServerName string `mapstructure:"server_name"`
+	// CertFile and KeyFile are the client certificate of the probe under mutual TLS.
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+}
+
+type Health struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+	// DrainDelay is how long the server keeps serving after reporting
+	// NOT_SERVING on shutdown, so that load balancers stop sending requests.
+	DrainDelay time.Duration `mapstructure:"drain_delay"`
+}
+
+type Tracing struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	ServiceName string  `mapstructure:"service_name"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+type Metrics struct {
+	Port int `mapstructure:"port"`
+}
+
+type RateLimit struct {
+	Enabled bool  `mapstructure:"enabled"`
+	Default Limit `mapstructure:"default"`
+	// Methods overrides Default per RPC, keyed by the lower-cased method name (e.g. "register").
+	Methods map[string]Limit `mapstructure:"methods"`
+}
+
+type Limit struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
+}
+
 func New() *Config {
 	_ = godotenv.Load(".env")
 
@@ -46,6 +149,19 @@ func New() *Config {
 	viper.SetDefault("env", env)
 	viper.SetDefault("database.dbport", 5432)
 	viper.SetDefault("database.dbhost", "localhost")
+	viper.SetDefault("tls.reload_interval", "1m")
+	viper.SetDefault("tls.probe.server_name", "localhost")
+	viper.SetDefault("health.interval", "5s")
+	viper.SetDefault("health.timeout", "1s")
+	viper.SetDefault("tracing.service_name", "event-service")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+	viper.SetDefault("retention.restore_period", "168h")
+	viper.SetDefault("retention.purge_after", "720h")
+	viper.SetDefault("retention.purge_interval", "1h")
+	viper.SetDefault("tickets.qr_size", 256)
+	viper.SetDefault("registration.closes_before_start", "0s")
+	viper.SetDefault("registration.cancellation_cutoff", "2h")
+	viper.SetDefault("registration.max_group_size", 50)
 
 	BindEnv()
 
@@ -72,4 +188,18 @@ func BindEnv() {
 
 	viper.BindEnv("redis.addr", "REDIS_ADDR")
 	viper.BindEnv("redis.password", "REDIS_PASSWORD")
+
+	viper.BindEnv("tls.enabled", "TLS_ENABLED")
+	viper.BindEnv("tls.cert_file", "TLS_CERT_FILE")
+	viper.BindEnv("tls.key_file", "TLS_KEY_FILE")
+	viper.BindEnv("tls.client_ca_file", "TLS_CLIENT_CA_FILE")
+	viper.BindEnv("tls.probe.ca_file", "TLS_PROBE_CA_FILE")
+	viper.BindEnv("tls.probe.server_name", "TLS_PROBE_SERVER_NAME")
+	viper.BindEnv("tls.probe.cert_file", "TLS_PROBE_CERT_FILE")
+	viper.BindEnv("tls.probe.key_file", "TLS_PROBE_KEY_FILE")
+
+	viper.BindEnv("tickets.secret", "TICKET_SECRET")
+
+	viper.BindEnv("tracing.enabled", "TRACING_ENABLED")
+	viper.BindEnv("tracing.endpoint", "OTEL_EXPORTER_OTLP_ENDPOINT")
 }
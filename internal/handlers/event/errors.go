@@ -0,0 +1,186 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/Estriper0/EventService/internal/service"
+	pb "github.com/Estriper0/protobuf/gen/event"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the ErrorInfo domain of all errors returned by the service.
+var errorDomain = pb.Event_ServiceDesc.ServiceName
+
+const reasonInvalidArgument = "INVALID_ARGUMENT"
+
+var serviceErrors = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{service.ErrRecordNotFound, codes.NotFound, "EVENT_NOT_FOUND"},
+	{service.ErrRegistered, codes.AlreadyExists, "ALREADY_REGISTERED"},
+	{service.ErrNotRegistered, codes.NotFound, "NOT_REGISTERED"},
+	{service.ErrMaxRegistered, codes.ResourceExhausted, "EVENT_FULL"},
+	{service.ErrUnauthenticated, codes.Unauthenticated, "UNAUTHENTICATED"},
+	{service.ErrPermissionDenied, codes.PermissionDenied, "PERMISSION_DENIED"},
+	{service.ErrVersionMismatch, codes.Aborted, "VERSION_MISMATCH"},
+	{service.ErrRestoreExpired, codes.FailedPrecondition, "RESTORE_PERIOD_EXPIRED"},
+	{service.ErrPending, codes.AlreadyExists, "REGISTRATION_PENDING"},
+	{service.ErrNotPending, codes.NotFound, "PENDING_REGISTRATION_NOT_FOUND"},
+	{service.ErrRejected, codes.FailedPrecondition, "REGISTRATION_REJECTED"},
+	{service.ErrTicketsDisabled, codes.FailedPrecondition, "TICKETS_DISABLED"},
+	{service.ErrInvalidTicket, codes.InvalidArgument, "INVALID_TICKET"},
+	{service.ErrCheckedIn, codes.AlreadyExists, "ALREADY_CHECKED_IN"},
+	{service.ErrSoldOut, codes.ResourceExhausted, "TICKET_TYPE_SOLD_OUT"},
+	{service.ErrSaleClosed, codes.FailedPrecondition, "TICKET_SALE_CLOSED"},
+	{service.ErrTicketTypeExists, codes.AlreadyExists, "TICKET_TYPE_EXISTS"},
+	{service.ErrQuestionNotFound, codes.NotFound, "QUESTION_NOT_FOUND"},
+	{service.ErrInviteNotFound, codes.NotFound, "INVITE_NOT_FOUND"},
+	{service.ErrInviteRequired, codes.PermissionDenied, "INVITE_REQUIRED"},
+	{service.ErrInvalidInvite, codes.PermissionDenied, "INVALID_INVITE"},
+	{service.ErrOrganizerNotFound, codes.NotFound, "ORGANIZER_NOT_FOUND"},
+	{service.ErrRepositoryError, codes.Internal, "INTERNAL"},
+}
+
+// toStatus converts an error of the service layer to a gRPC status with an
+// ErrorInfo detail. Unknown errors are reported as Internal without the cause.
+func toStatus(err error) error {
+	var ruleErr *service.RuleError
+	if errors.As(err, &ruleErr) {
+		return ruleStatus(ruleErr)
+	}
+	for _, e := range serviceErrors {
+		if errors.Is(err, e.err) {
+			message := err.Error()
+			if e.code == codes.Internal {
+				message = "internal error"
+			}
+			return withDetails(status.New(e.code, message), &errdetails.ErrorInfo{
+				Reason: e.reason,
+				Domain: errorDomain,
+			})
+		}
+	}
+	return withDetails(status.New(codes.Internal, "internal error"), &errdetails.ErrorInfo{
+		Reason: "INTERNAL",
+		Domain: errorDomain,
+	})
+}
+
+// ruleStatus reports a violated domain rule as InvalidArgument with a
+// BadRequest detail or as FailedPrecondition with a PreconditionFailure detail.
+func ruleStatus(err *service.RuleError) error {
+	info := &errdetails.ErrorInfo{
+		Reason: err.Reason,
+		Domain: errorDomain,
+	}
+	if errors.Is(err, service.ErrFailedPrecondition) {
+		return withDetails(
+			status.New(codes.FailedPrecondition, err.Error()),
+			&errdetails.PreconditionFailure{
+				Violations: []*errdetails.PreconditionFailure_Violation{{
+					Type:        err.Reason,
+					Subject:     err.Field,
+					Description: err.Message,
+				}},
+			},
+			info,
+		)
+	}
+	return withDetails(
+		status.New(codes.InvalidArgument, err.Error()),
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       err.Field,
+				Description: err.Message,
+				Reason:      err.Reason,
+			}},
+		},
+		info,
+	)
+}
+
+// validationStatus converts validator errors to InvalidArgument with a
+// BadRequest detail listing every violated field. field names the value
+// checked with validator.Var, struct fields are named after their proto fields.
+func validationStatus(err error, field string) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return withDetails(status.New(codes.InvalidArgument, err.Error()), &errdetails.ErrorInfo{
+			Reason: reasonInvalidArgument,
+			Domain: errorDomain,
+		})
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	descriptions := make([]string, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		name := field
+		if fe.Field() != "" {
+			name = snakeCase(fe.Field())
+		}
+		description := describe(name, fe)
+		descriptions = append(descriptions, description)
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       name,
+			Description: description,
+			Reason:      strings.ToUpper(fe.Tag()),
+		})
+	}
+
+	return withDetails(
+		status.New(codes.InvalidArgument, strings.Join(descriptions, "; ")),
+		badRequest,
+		&errdetails.ErrorInfo{
+			Reason: reasonInvalidArgument,
+			Domain: errorDomain,
+		},
+	)
+}
+
+func describe(field string, fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", field)
+	case "min":
+		return fmt.Sprintf("%s must be at least %s", field, fe.Param())
+	case "max":
+		return fmt.Sprintf("%s must be at most %s", field, fe.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", field, strings.ReplaceAll(fe.Param(), " ", ", "))
+	case "uuid":
+		return fmt.Sprintf("%s must be a valid UUID", field)
+	}
+	return fmt.Sprintf("%s failed the %s rule", field, fe.Tag())
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// snakeCase turns a Go field name into the proto field name, e.g. MaxAttendees into max_attendees.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package event
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name:        "not found",
+			err:         service.ErrRecordNotFound,
+			wantCode:    codes.NotFound,
+			wantReason:  "EVENT_NOT_FOUND",
+			wantMessage: service.ErrRecordNotFound.Error(),
+		},
+		{
+			name:        "wrapped error",
+			err:         fmt.Errorf("register: %w", service.ErrMaxRegistered),
+			wantCode:    codes.ResourceExhausted,
+			wantReason:  "EVENT_FULL",
+			wantMessage: "register: " + service.ErrMaxRegistered.Error(),
+		},
+		{
+			name:        "permission denied",
+			err:         service.ErrPermissionDenied,
+			wantCode:    codes.PermissionDenied,
+			wantReason:  "PERMISSION_DENIED",
+			wantMessage: service.ErrPermissionDenied.Error(),
+		},
+		{
+			name:        "repository error hides the cause",
+			err:         service.ErrRepositoryError,
+			wantCode:    codes.Internal,
+			wantReason:  "INTERNAL",
+			wantMessage: "internal error",
+		},
+		{
+			name:        "unknown error",
+			err:         assert.AnError,
+			wantCode:    codes.Internal,
+			wantReason:  "INTERNAL",
+			wantMessage: "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(toStatus(tt.err))
+
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Equal(t, tt.wantMessage, st.Message())
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantReason, info.Reason)
+			assert.Equal(t, errorDomain, info.Domain)
+		})
+	}
+}
+
+func TestToStatus_RuleError(t *testing.T) {
+	st := status.Convert(toStatus(service.ErrStartDateInPast))
+
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 2)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "start_date", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "START_DATE_IN_PAST", badRequest.FieldViolations[0].Reason)
+
+	st = status.Convert(toStatus(service.ErrCapacityBelowAttendance))
+
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 2)
+	failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	require.True(t, ok)
+	require.Len(t, failure.Violations, 1)
+	assert.Equal(t, "CAPACITY_BELOW_ATTENDANCE", failure.Violations[0].Type)
+	info, ok := st.Details()[1].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "CAPACITY_BELOW_ATTENDANCE", info.Reason)
+}
+
+func TestValidationStatus(t *testing.T) {
+	validate := validator.New()
+
+	tests := []struct {
+		name           string
+		err            error
+		field          string
+		wantViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name: "struct",
+			err: validate.Struct(&models.EventCreateRequest{
+				Title:        "Team",
+				About:        "Weekly team meeting",
+				Location:     "Zoom",
+				Status:       "unknown",
+				MaxAttendees: 20,
+				Creator:      "ea27ecf4-02b1-453d-965d-408253a874b9",
+			}),
+			wantViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "title", Reason: "MIN", Description: "title must be at least 5"},
+				{Field: "start_date", Reason: "REQUIRED", Description: "start_date is required"},
+				{Field: "status", Reason: "ONEOF", Description: "status must be one of: draft, published, ongoing, completed, cancelled, postponed"},
+			},
+		},
+		{
+			name:  "variable",
+			err:   validate.Var("user", "uuid,required"),
+			field: "user_id",
+			wantViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "user_id", Reason: "UUID", Description: "user_id must be a valid UUID"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(validationStatus(tt.err, tt.field))
+
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			require.Len(t, st.Details(), 2)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Len(t, badRequest.FieldViolations, len(tt.wantViolations))
+			for i, want := range tt.wantViolations {
+				assert.Equal(t, want.Field, badRequest.FieldViolations[i].Field)
+				assert.Equal(t, want.Reason, badRequest.FieldViolations[i].Reason)
+				assert.Equal(t, want.Description, badRequest.FieldViolations[i].Description)
+			}
+		})
+	}
+}
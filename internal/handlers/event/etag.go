@@ -0,0 +1,75 @@
+package event
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys carrying the event version as an ETag, e.g. "3".
+// GetById returns it in the etag header, Update and DeleteById accept it in
+// if-match. When the if-none-match version of GetById is still current the
+// response header x-not-modified is "true" and the client may keep its copy.
+const (
+	ETagKey        = "etag"
+	IfMatchKey     = "if-match"
+	IfNoneMatchKey = "if-none-match"
+	NotModifiedKey = "x-not-modified"
+)
+
+func formatETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// setETag sends the version of the event in the response header and marks the
+// response as not modified when it is the ifNoneMatch version.
+func setETag(ctx context.Context, version int, ifNoneMatch int) {
+	md := metadata.Pairs(ETagKey, formatETag(version))
+	if ifNoneMatch != 0 && ifNoneMatch == version {
+		md.Set(NotModifiedKey, "true")
+	}
+	grpc.SetHeader(ctx, md)
+}
+
+// etagVersion reads the version from a conditional metadata header.
+// It returns zero when the header is absent or is "*".
+func etagVersion(ctx context.Context, key string) (int, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	value := strings.TrimSpace(values[0])
+	if value == "*" {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(value, "W/"), `"`))
+	if err != nil || version <= 0 {
+		description := key + " must be an ETag returned in the etag header"
+		return 0, withDetails(
+			status.New(codes.InvalidArgument, description),
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+					Field:       key,
+					Description: description,
+					Reason:      "INVALID_ETAG",
+				}},
+			},
+			&errdetails.ErrorInfo{
+				Reason: reasonInvalidArgument,
+				Domain: errorDomain,
+			},
+		)
+	}
+	return version, nil
+}
@@ -0,0 +1,127 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	pb "github.com/Estriper0/protobuf/gen/event"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestEtagVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		md          metadata.MD
+		wantVersion int
+		wantCode    codes.Code
+	}{
+		{
+			name:        "no header",
+			md:          metadata.Pairs(),
+			wantVersion: 0,
+		},
+		{
+			name:        "quoted",
+			md:          metadata.Pairs(IfMatchKey, `"3"`),
+			wantVersion: 3,
+		},
+		{
+			name:        "weak",
+			md:          metadata.Pairs(IfMatchKey, `W/"4"`),
+			wantVersion: 4,
+		},
+		{
+			name:        "unquoted",
+			md:          metadata.Pairs(IfMatchKey, "5"),
+			wantVersion: 5,
+		},
+		{
+			name:        "any",
+			md:          metadata.Pairs(IfMatchKey, "*"),
+			wantVersion: 0,
+		},
+		{
+			name:     "not a number",
+			md:       metadata.Pairs(IfMatchKey, `"abc"`),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "zero",
+			md:       metadata.Pairs(IfMatchKey, `"0"`),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			version, err := etagVersion(ctx, IfMatchKey)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
+
+// headerStream records the headers set by a handler.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestGetById_ETag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &EventGRPCService{eventService: eventService}
+
+	tests := []struct {
+		name            string
+		md              metadata.MD
+		wantNotModified []string
+	}{
+		{
+			name: "no if-none-match",
+			md:   metadata.Pairs(),
+		},
+		{
+			name: "modified",
+			md:   metadata.Pairs(IfNoneMatchKey, `"2"`),
+		},
+		{
+			name:            "not modified",
+			md:              metadata.Pairs(IfNoneMatchKey, `"3"`),
+			wantNotModified: []string{"true"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(context.Background(), tt.md), stream)
+			eventService.EXPECT().GetById(gomock.Any(), 1).Return(&models.EventResponse{Id: 1, Title: "Event", Version: 3}, nil)
+
+			resp, err := handler.GetById(ctx, &pb.GetByIdRequest{Id: 1})
+
+			require.NoError(t, err)
+			assert.Equal(t, "Event", resp.Title)
+			assert.Equal(t, []string{`"3"`}, stream.header.Get(ETagKey))
+			assert.Equal(t, tt.wantNotModified, stream.header.Get(NotModifiedKey))
+		})
+	}
+}
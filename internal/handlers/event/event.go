@@ -2,15 +2,13 @@ package event
 
 import (
 	"context"
-	"errors"
 
+	"github.com/Estriper0/EventService/api/management"
 	"github.com/Estriper0/EventService/internal/models"
 	"github.com/Estriper0/EventService/internal/service"
 	pb "github.com/Estriper0/protobuf/gen/event"
 	"github.com/go-playground/validator/v10"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -21,7 +19,9 @@ type EventGRPCService struct {
 }
 
 func Register(gRPC *grpc.Server, eventService service.IEventService) {
-	pb.RegisterEventServer(gRPC, &EventGRPCService{eventService: eventService, validate: validator.New()})
+	validate := validator.New()
+	pb.RegisterEventServer(gRPC, &EventGRPCService{eventService: eventService, validate: validate})
+	management.RegisterEventManagementServer(gRPC, &ManagementGRPCService{eventService: eventService, validate: validate})
 }
 
 func (s *EventGRPCService) GetAll(
@@ -29,8 +29,8 @@ func (s *EventGRPCService) GetAll(
 	req *pb.EmptyRequest,
 ) (*pb.GetAllResponse, error) {
 	events, err := s.eventService.GetAll(ctx)
-	if errors.Is(err, service.ErrRepositoryError) {
-		return nil, status.Error(codes.Internal, "internal error")
+	if err != nil {
+		return nil, toStatus(err)
 	}
 	response := &pb.GetAllResponse{
 		Events: []*pb.EventElem{},
@@ -56,13 +56,15 @@ func (s *EventGRPCService) GetById(
 	ctx context.Context,
 	req *pb.GetByIdRequest,
 ) (*pb.GetByIdResponse, error) {
+	ifNoneMatch, err := etagVersion(ctx, IfNoneMatchKey)
+	if err != nil {
+		return nil, err
+	}
 	event, err := s.eventService.GetById(ctx, int(req.Id))
 	if err != nil {
-		if errors.Is(err, service.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, toStatus(err)
 	}
+	setETag(ctx, event.Version, ifNoneMatch)
 	return &pb.GetByIdResponse{
 		Id:                int64(event.Id),
 		Title:             event.Title,
@@ -92,12 +94,12 @@ func (s *EventGRPCService) Create(
 
 	err := s.validate.Struct(event)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, validationStatus(err, "")
 	}
 
 	id, err := s.eventService.Create(ctx, event)
-	if errors.Is(err, service.ErrRepositoryError) {
-		return nil, status.Error(codes.Internal, "internal error")
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
 	return &pb.CreateResponse{
@@ -109,12 +111,13 @@ func (s *EventGRPCService) DeleteById(
 	ctx context.Context,
 	req *pb.DeleteByIdRequest,
 ) (*pb.DeleteByIdResponse, error) {
-	err := s.eventService.DeleteById(ctx, int(req.Id))
+	version, err := etagVersion(ctx, IfMatchKey)
 	if err != nil {
-		if errors.Is(err, service.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, err
+	}
+	err = s.eventService.DeleteById(ctx, int(req.Id), version)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 	return &pb.DeleteByIdResponse{
 		Id: req.Id,
@@ -134,15 +137,31 @@ func (s *EventGRPCService) Update(
 		Status:       req.Status,
 		MaxAttendees: int(req.MaxAttendees),
 	}
-	if err := s.validate.Struct(event_update); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	version, err := etagVersion(ctx, IfMatchKey)
+	if err != nil {
+		return nil, err
 	}
-	err := s.eventService.Update(ctx, event_update)
+	event_update.Version = version
+	mask, err := updateMask(ctx)
 	if err != nil {
-		if errors.Is(err, service.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
+		return nil, err
+	}
+	if mask == nil {
+		err = s.validate.Struct(event_update)
+	} else {
+		fields := []string{"Id"}
+		for _, path := range mask.Paths {
+			fields = append(fields, updateFields[path])
 		}
-		return nil, status.Error(codes.Internal, "internal error")
+		event_update.Fields = mask.Paths
+		err = s.validate.StructPartial(event_update, fields...)
+	}
+	if err != nil {
+		return nil, validationStatus(err, "")
+	}
+	err = s.eventService.Update(ctx, event_update)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 	return &pb.EmptyResponse{}, nil
 }
@@ -152,11 +171,15 @@ func (s *EventGRPCService) GetAllByCreator(
 	req *pb.GetAllByCreatorRequest,
 ) (*pb.GetAllResponse, error) {
 	if err := s.validate.Var(req.Creator, "required,uuid"); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, validationStatus(err, "creator")
 	}
-	events, err := s.eventService.GetAllByCreator(ctx, req.Creator)
-	if errors.Is(err, service.ErrRepositoryError) {
-		return nil, status.Error(codes.Internal, "internal error")
+	withOrganized, err := organized(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.eventService.GetAllByCreator(ctx, req.Creator, withOrganized)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 	response := &pb.GetAllResponse{
 		Events: []*pb.EventElem{},
@@ -183,11 +206,11 @@ func (s *EventGRPCService) GetAllByStatus(
 	req *pb.GetAllByStatusRequest,
 ) (*pb.GetAllResponse, error) {
 	if err := s.validate.Var(req.Status, "required,oneof=draft published ongoing completed cancelled postponed"); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, validationStatus(err, "status")
 	}
 	events, err := s.eventService.GetAllByStatus(ctx, req.Status)
-	if errors.Is(err, service.ErrRepositoryError) {
-		return nil, status.Error(codes.Internal, "internal error")
+	if err != nil {
+		return nil, toStatus(err)
 	}
 	response := &pb.GetAllResponse{
 		Events: []*pb.EventElem{},
@@ -215,20 +238,15 @@ func (s *EventGRPCService) Register(
 ) (*pb.EmptyResponse, error) {
 	err := s.validate.Var(req.UserId, "uuid,required")
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, validationStatus(err, "user_id")
 	}
-	err = s.eventService.Register(ctx, req.UserId, int(req.EventId))
+	options, err := registrationOptions(ctx)
 	if err != nil {
-		if errors.Is(err, service.ErrMaxRegistered) {
-			return nil, status.Error(codes.ResourceExhausted, err.Error())
-		}
-		if errors.Is(err, service.ErrRegistered) {
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		}
-		if errors.Is(err, service.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, err
+	}
+	err = s.eventService.Register(ctx, req.UserId, int(req.EventId), options.ticketTypeId, options.guests, options.answers, options.code)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 	return &pb.EmptyResponse{}, nil
 }
@@ -239,17 +257,11 @@ func (s *EventGRPCService) CancellRegister(
 ) (*pb.EmptyResponse, error) {
 	err := s.validate.Var(req.UserId, "uuid,required")
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, validationStatus(err, "user_id")
 	}
 	err = s.eventService.CancellRegister(ctx, req.UserId, int(req.EventId))
 	if err != nil {
-		if errors.Is(err, service.ErrNotRegistered) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		if errors.Is(err, service.ErrRecordNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, toStatus(err)
 	}
 	return &pb.EmptyResponse{}, nil
 }
@@ -260,16 +272,20 @@ func (s *EventGRPCService) GetAllByUser(
 ) (*pb.GetAllByUserResponse, error) {
 	err := s.validate.Var(req.UserId, "uuid,required")
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, validationStatus(err, "user_id")
 	}
-	events, err := s.eventService.GetAllByUser(ctx, req.UserId)
+	registrations, err := s.eventService.GetAllByUser(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, toStatus(err)
 	}
 	response := &pb.GetAllByUserResponse{
 		Events: []*pb.EventElem{},
 	}
-	for _, event := range events {
+	for _, registration := range registrations {
+		if registration.Registration.Status != models.RegistrationActive {
+			continue
+		}
+		event := registration.Event
 		pb_event := &pb.EventElem{
 			Id:                int64(event.Id),
 			Title:             event.Title,
@@ -290,13 +306,15 @@ func (s *EventGRPCService) GetAllUsersByEvent(
 	ctx context.Context,
 	req *pb.GetAllUsersByEventRequest,
 ) (*pb.GetAllUsersByEventResponse, error) {
-	users_id, err := s.eventService.GetAllUsersByEvent(ctx, int(req.EventId))
+	registrations, err := s.eventService.GetAllUsersByEvent(ctx, int(req.EventId))
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, toStatus(err)
 	}
 	response := &pb.GetAllUsersByEventResponse{}
-	for _, id := range *users_id {
-		response.UsersId = append(response.UsersId, id)
+	for _, registration := range registrations {
+		if registration.Status == models.RegistrationActive {
+			response.UsersId = append(response.UsersId, registration.UserId)
+		}
 	}
 	return response, nil
 }
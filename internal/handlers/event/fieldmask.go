@@ -0,0 +1,82 @@
+package event
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/models"
+	pb "github.com/Estriper0/protobuf/gen/event"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// UpdateMaskKey is the metadata key with a comma separated google.protobuf.FieldMask
+// of UpdateRequest paths, e.g. "title,start_date". Without it Update writes every field.
+const UpdateMaskKey = "x-update-mask"
+
+// updateFields maps the UpdateRequest paths to the fields of models.EventUpdateRequest.
+var updateFields = map[string]string{
+	models.FieldTitle:        "Title",
+	models.FieldAbout:        "About",
+	models.FieldStartDate:    "StartDate",
+	models.FieldLocation:     "Location",
+	models.FieldStatus:       "Status",
+	models.FieldMaxAttendees: "MaxAttendees",
+}
+
+// updateMask reads the field mask of an Update call from the metadata.
+// It returns nil when the caller did not send one.
+func updateMask(ctx context.Context) (*fieldmaskpb.FieldMask, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(UpdateMaskKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	var paths []string
+	for _, value := range values {
+		for _, path := range strings.Split(value, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	mask, err := fieldmaskpb.New(&pb.UpdateRequest{}, paths...)
+	if err != nil {
+		return nil, invalidMask(err.Error())
+	}
+	mask.Normalize()
+	for _, path := range mask.Paths {
+		if _, ok := updateFields[path]; !ok {
+			return nil, invalidMask("field " + path + " cannot be updated")
+		}
+	}
+	if len(mask.Paths) == 0 {
+		return nil, invalidMask("the update mask is empty")
+	}
+	return mask, nil
+}
+
+func invalidMask(description string) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, description),
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       "update_mask",
+				Description: description,
+				Reason:      "INVALID_FIELD_MASK",
+			}},
+		},
+		&errdetails.ErrorInfo{
+			Reason: reasonInvalidArgument,
+			Domain: errorDomain,
+		},
+	)
+}
@@ -0,0 +1,80 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateMask(t *testing.T) {
+	tests := []struct {
+		name      string
+		md        metadata.MD
+		wantPaths []string
+		wantCode  codes.Code
+	}{
+		{
+			name:      "no metadata",
+			md:        nil,
+			wantPaths: nil,
+		},
+		{
+			name:      "no mask",
+			md:        metadata.Pairs("x-user-id", "ea27ecf4-02b1-453d-965d-408253a874b9"),
+			wantPaths: nil,
+		},
+		{
+			name:      "mask",
+			md:        metadata.Pairs(UpdateMaskKey, "title, start_date"),
+			wantPaths: []string{"start_date", "title"},
+		},
+		{
+			name:      "several values with duplicates",
+			md:        metadata.Pairs(UpdateMaskKey, "location", UpdateMaskKey, "location,max_attendees"),
+			wantPaths: []string{"location", "max_attendees"},
+		},
+		{
+			name:     "unknown field",
+			md:       metadata.Pairs(UpdateMaskKey, "title,creator"),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "id cannot be updated",
+			md:       metadata.Pairs(UpdateMaskKey, "id"),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "empty mask",
+			md:       metadata.Pairs(UpdateMaskKey, " , "),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			mask, err := updateMask(ctx)
+
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				assert.Nil(t, mask)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantPaths == nil {
+				assert.Nil(t, mask)
+				return
+			}
+			assert.Equal(t, tt.wantPaths, mask.Paths)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ManagementGRPCService) RegisterGroup(
+	ctx context.Context,
+	req *management.RegisterGroupRequest,
+) (*management.RegisterGroupResponse, error) {
+	for i, user_id := range req.UserIds {
+		if err := s.validate.Var(user_id, "uuid,required"); err != nil {
+			return nil, validationStatus(err, fmt.Sprintf("user_ids[%d]", i))
+		}
+	}
+	outcomes, err := s.eventService.RegisterGroup(ctx, int(req.EventId), req.UserIds, int(req.TicketTypeId), req.Partial)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &management.RegisterGroupResponse{
+		Outcomes: []*management.GroupOutcome{},
+	}
+	for _, outcome := range outcomes {
+		response.Outcomes = append(response.Outcomes, groupOutcome(outcome))
+	}
+	return response, nil
+}
+
+// groupOutcome reports the error of a user with the reason and the message toStatus gives it.
+func groupOutcome(outcome *models.GroupOutcome) *management.GroupOutcome {
+	pb_outcome := &management.GroupOutcome{
+		UserId:  outcome.UserId,
+		Outcome: outcome.Outcome,
+	}
+	if outcome.Err == nil {
+		return pb_outcome
+	}
+	st := status.Convert(toStatus(outcome.Err))
+	pb_outcome.Message = st.Message()
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			pb_outcome.Reason = info.Reason
+		}
+	}
+	return pb_outcome
+}
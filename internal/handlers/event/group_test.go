@@ -0,0 +1,73 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegisterGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	const otherUserId = "5f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"
+
+	t.Run("partial", func(t *testing.T) {
+		eventService.EXPECT().RegisterGroup(gomock.Any(), 1, []string{testUserId, otherUserId}, 2, true).Return([]*models.GroupOutcome{
+			{UserId: testUserId, Outcome: models.GroupRegistered},
+			{UserId: otherUserId, Outcome: models.GroupFailed, Err: service.ErrMaxRegistered},
+		}, nil)
+
+		resp, err := handler.RegisterGroup(context.Background(), &management.RegisterGroupRequest{
+			EventId:      1,
+			UserIds:      []string{testUserId, otherUserId},
+			TicketTypeId: 2,
+			Partial:      true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Outcomes, 2)
+		assert.Equal(t, models.GroupRegistered, resp.Outcomes[0].Outcome)
+		assert.Empty(t, resp.Outcomes[0].Reason)
+		assert.Equal(t, models.GroupFailed, resp.Outcomes[1].Outcome)
+		assert.Equal(t, "EVENT_FULL", resp.Outcomes[1].Reason)
+		assert.Equal(t, service.ErrMaxRegistered.Error(), resp.Outcomes[1].Message)
+	})
+
+	t.Run("all or none", func(t *testing.T) {
+		eventService.EXPECT().RegisterGroup(gomock.Any(), 1, []string{testUserId}, 0, false).Return(nil, service.ErrSoldOut)
+
+		_, err := handler.RegisterGroup(context.Background(), &management.RegisterGroupRequest{
+			EventId: 1,
+			UserIds: []string{testUserId},
+		})
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("invalid user id", func(t *testing.T) {
+		_, err := handler.RegisterGroup(context.Background(), &management.RegisterGroupRequest{
+			EventId: 1,
+			UserIds: []string{testUserId, "user"},
+		})
+
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		badRequest, ok := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "user_ids[1]", badRequest.FieldViolations[0].Field)
+	})
+}
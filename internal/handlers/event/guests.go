@@ -0,0 +1,22 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+)
+
+func (s *ManagementGRPCService) ChangeGuests(
+	ctx context.Context,
+	req *management.ChangeGuestsRequest,
+) (*management.EmptyResponse, error) {
+	err := s.validate.Var(req.UserId, "uuid,required")
+	if err != nil {
+		return nil, validationStatus(err, "user_id")
+	}
+	err = s.eventService.ChangeGuests(ctx, req.UserId, int(req.EventId), int(req.Guests))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
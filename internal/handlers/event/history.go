@@ -0,0 +1,78 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ManagementGRPCService) GetHistory(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.GetHistoryResponse, error) {
+	history, err := s.eventService.GetHistory(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &management.GetHistoryResponse{
+		Revisions: []*management.Revision{},
+	}
+	for _, revision := range history {
+		changes, err := fieldChanges(revision.Changes)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		pb_revision := &management.Revision{
+			Revision:  int32(revision.Revision),
+			Action:    revision.Action,
+			Actor:     revision.Actor,
+			ChangedAt: timestamppb.New(revision.ChangedAt),
+			Changes:   changes,
+		}
+		if revision.Snapshot != nil {
+			pb_revision.Snapshot = toEventResponse(revision.Snapshot)
+		}
+		response.Revisions = append(response.Revisions, pb_revision)
+	}
+	return response, nil
+}
+
+func (s *ManagementGRPCService) GetRevision(
+	ctx context.Context,
+	req *management.GetRevisionRequest,
+) (*management.EventResponse, error) {
+	if err := s.validate.Var(req.Revision, "min=1"); err != nil {
+		return nil, validationStatus(err, "revision")
+	}
+	event, err := s.eventService.GetRevision(ctx, int(req.EventId), int(req.Revision))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toEventResponse(event), nil
+}
+
+// fieldChanges encodes the changed values as JSON, ordered by the field name.
+func fieldChanges(changes map[string]models.FieldChange) ([]*management.FieldChange, error) {
+	result := make([]*management.FieldChange, 0, len(changes))
+	for _, field := range slices.Sorted(maps.Keys(changes)) {
+		oldValue, err := json.Marshal(changes[field].Old)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := json.Marshal(changes[field].New)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &management.FieldChange{
+			Field:    field,
+			OldValue: string(oldValue),
+			NewValue: string(newValue),
+		})
+	}
+	return result, nil
+}
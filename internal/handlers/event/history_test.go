@@ -0,0 +1,106 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	changedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	eventService.EXPECT().GetHistory(gomock.Any(), 1).Return([]*models.EventHistory{
+		{
+			EventId:   1,
+			Revision:  2,
+			Action:    models.ActionUpdate,
+			Actor:     "0b9a5a0e-5b0c-4f8e-9d35-6f0d6c1b7a11",
+			ChangedAt: changedAt,
+			Changes: map[string]models.FieldChange{
+				models.FieldTitle:        {Old: "Old title", New: "New title"},
+				models.FieldMaxAttendees: {Old: 10, New: 20},
+			},
+			Snapshot: &models.EventResponse{Id: 1, Title: "New title", MaxAttendees: 20, Version: 2},
+		},
+	}, nil)
+
+	resp, err := handler.GetHistory(context.Background(), &management.EventRequest{EventId: 1})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 1)
+	revision := resp.Revisions[0]
+	assert.Equal(t, int32(2), revision.Revision)
+	assert.Equal(t, models.ActionUpdate, revision.Action)
+	assert.Equal(t, changedAt, revision.ChangedAt.AsTime())
+	require.Len(t, revision.Changes, 2)
+	assert.Equal(t, "max_attendees", revision.Changes[0].Field)
+	assert.Equal(t, "10", revision.Changes[0].OldValue)
+	assert.Equal(t, "20", revision.Changes[0].NewValue)
+	assert.Equal(t, "title", revision.Changes[1].Field)
+	assert.Equal(t, `"Old title"`, revision.Changes[1].OldValue)
+	assert.Equal(t, int32(2), revision.Snapshot.Version)
+}
+
+func TestGetRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	tests := []struct {
+		name     string
+		revision int32
+		mock     func()
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			revision: 1,
+			mock: func() {
+				eventService.EXPECT().GetRevision(gomock.Any(), 1, 1).Return(&models.EventResponse{Id: 1, Version: 1}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "not found",
+			revision: 5,
+			mock: func() {
+				eventService.EXPECT().GetRevision(gomock.Any(), 1, 5).Return(nil, service.ErrRecordNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "invalid revision",
+			revision: 0,
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			_, err := handler.GetRevision(context.Background(), &management.GetRevisionRequest{EventId: 1, Revision: tt.revision})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
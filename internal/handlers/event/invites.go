@@ -0,0 +1,63 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ManagementGRPCService) CreateInvite(
+	ctx context.Context,
+	req *management.CreateInviteRequest,
+) (*management.Invite, error) {
+	invite, err := s.eventService.CreateInvite(ctx, int(req.EventId), req.UserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toInvite(invite), nil
+}
+
+func (s *ManagementGRPCService) GetInvites(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.GetInvitesResponse, error) {
+	invites, err := s.eventService.GetInvites(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &management.GetInvitesResponse{
+		Invites: []*management.Invite{},
+	}
+	for _, invite := range invites {
+		response.Invites = append(response.Invites, toInvite(invite))
+	}
+	return response, nil
+}
+
+func (s *ManagementGRPCService) RevokeInvite(
+	ctx context.Context,
+	req *management.RevokeInviteRequest,
+) (*management.EmptyResponse, error) {
+	err := s.validate.Var(req.Code, "required")
+	if err != nil {
+		return nil, validationStatus(err, "code")
+	}
+	err = s.eventService.RevokeInvite(ctx, int(req.EventId), req.Code)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
+
+func toInvite(invite *models.Invite) *management.Invite {
+	return &management.Invite{
+		Code:      invite.Code,
+		EventId:   int64(invite.EventId),
+		UserId:    invite.UserId,
+		CreatedBy: invite.CreatedBy,
+		CreatedAt: timestamppb.New(invite.CreatedAt),
+		RevokedAt: optionalTimestamp(invite.RevokedAt),
+	}
+}
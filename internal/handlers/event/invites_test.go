@@ -0,0 +1,90 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	eventService.EXPECT().CreateInvite(gomock.Any(), 1, testUserId).Return(&models.Invite{
+		Code:      "code",
+		EventId:   1,
+		UserId:    testUserId,
+		CreatedBy: "5f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+		CreatedAt: createdAt,
+	}, nil)
+
+	resp, err := handler.CreateInvite(context.Background(), &management.CreateInviteRequest{EventId: 1, UserId: testUserId})
+
+	require.NoError(t, err)
+	assert.Equal(t, "code", resp.Code)
+	assert.Equal(t, testUserId, resp.UserId)
+	assert.Equal(t, createdAt, resp.CreatedAt.AsTime())
+	assert.Nil(t, resp.RevokedAt)
+}
+
+func TestRevokeInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	tests := []struct {
+		name     string
+		code     string
+		mock     func()
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			code: "code",
+			mock: func() {
+				eventService.EXPECT().RevokeInvite(gomock.Any(), 1, "code").Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "not found",
+			code: "code",
+			mock: func() {
+				eventService.EXPECT().RevokeInvite(gomock.Any(), 1, "code").Return(service.ErrInviteNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "no code",
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			_, err := handler.RevokeInvite(context.Background(), &management.RevokeInviteRequest{EventId: 1, Code: tt.code})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
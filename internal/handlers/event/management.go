@@ -0,0 +1,101 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ManagementGRPCService serves the EventManagement service, the features of
+// events that the Event contract of github.com/Estriper0/protobuf has no RPCs for.
+type ManagementGRPCService struct {
+	management.UnimplementedEventManagementServer
+	eventService service.IEventService
+	validate     *validator.Validate
+}
+
+func toEventResponse(event *models.EventResponse) *management.EventResponse {
+	return &management.EventResponse{
+		Id:                int64(event.Id),
+		Title:             event.Title,
+		About:             event.About,
+		StartDate:         timestamppb.New(event.StartDate),
+		Location:          event.Location,
+		Status:            event.Status,
+		MaxAttendees:      int32(event.MaxAttendees),
+		CurrentAttendance: int32(event.CurrentAttendance),
+		Creator:           event.Creator,
+		Version:           int32(event.Version),
+		Settings:          toSettings(event),
+	}
+}
+
+func toSettings(event *models.EventResponse) *management.Settings {
+	settings := &management.Settings{
+		RequiresApproval:     event.RequiresApproval,
+		RegistrationOpensAt:  optionalTimestamp(event.RegistrationOpensAt),
+		RegistrationClosesAt: optionalTimestamp(event.RegistrationClosesAt),
+		MaxGuests:            int32(event.MaxGuests),
+		AllowTransfers:       event.AllowTransfers,
+		Visibility:           event.Visibility,
+	}
+	if event.CancellationCutoff != nil {
+		settings.CancellationCutoff = durationpb.New(*event.CancellationCutoff)
+	}
+	return settings
+}
+
+func toRegistration(registration *models.Registration) *management.Registration {
+	pb_registration := &management.Registration{
+		EventId:       int64(registration.EventId),
+		UserId:        registration.UserId,
+		Status:        registration.Status,
+		RegisteredAt:  timestamppb.New(registration.RegisteredAt),
+		CancelledAt:   optionalTimestamp(registration.CancelledAt),
+		Cancellations: int32(registration.Cancellations),
+		CheckedInAt:   optionalTimestamp(registration.CheckedInAt),
+		TicketTypeId:  int64(registration.TicketTypeId),
+		Guests:        int32(registration.Guests),
+	}
+	for _, answer := range registration.Answers {
+		pb_registration.Answers = append(pb_registration.Answers, &management.Answer{
+			QuestionId: int64(answer.QuestionId),
+			Values:     answer.Values,
+		})
+	}
+	return pb_registration
+}
+
+// optionalTimestamp converts a time that may be unset, leaving the timestamp nil.
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// optionalTime converts a timestamp that may be unset, leaving the time nil.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func (s *ManagementGRPCService) Restore(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.EmptyResponse, error) {
+	err := s.eventService.Restore(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
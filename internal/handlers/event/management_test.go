@@ -0,0 +1,54 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRestore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "restore period expired",
+			err:      service.ErrRestoreExpired,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "not the owner",
+			err:      service.ErrPermissionDenied,
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventService.EXPECT().Restore(gomock.Any(), 1).Return(tt.err)
+
+			_, err := handler.Restore(context.Background(), &management.EventRequest{EventId: 1})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
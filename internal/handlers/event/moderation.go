@@ -0,0 +1,69 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+)
+
+func (s *ManagementGRPCService) GetPending(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.RegistrationsResponse, error) {
+	registrations, err := s.eventService.GetPending(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &management.RegistrationsResponse{
+		Registrations: []*management.Registration{},
+	}
+	for _, registration := range registrations {
+		response.Registrations = append(response.Registrations, toRegistration(registration))
+	}
+	return response, nil
+}
+
+func (s *ManagementGRPCService) Approve(
+	ctx context.Context,
+	req *management.RegistrationRequest,
+) (*management.EmptyResponse, error) {
+	err := s.validate.Var(req.UserId, "uuid,required")
+	if err != nil {
+		return nil, validationStatus(err, "user_id")
+	}
+	err = s.eventService.Approve(ctx, req.UserId, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
+
+func (s *ManagementGRPCService) Reject(
+	ctx context.Context,
+	req *management.RegistrationRequest,
+) (*management.EmptyResponse, error) {
+	err := s.validate.Var(req.UserId, "uuid,required")
+	if err != nil {
+		return nil, validationStatus(err, "user_id")
+	}
+	err = s.eventService.Reject(ctx, req.UserId, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
+
+func (s *ManagementGRPCService) GetRegistration(
+	ctx context.Context,
+	req *management.RegistrationRequest,
+) (*management.Registration, error) {
+	err := s.validate.Var(req.UserId, "uuid,required")
+	if err != nil {
+		return nil, validationStatus(err, "user_id")
+	}
+	registration, err := s.eventService.GetRegistration(ctx, req.UserId, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toRegistration(registration), nil
+}
@@ -0,0 +1,122 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testUserId = "0b9a5a0e-5b0c-4f8e-9d35-6f0d6c1b7a11"
+
+func TestGetPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	registeredAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	eventService.EXPECT().GetPending(gomock.Any(), 1).Return([]*models.Registration{
+		{EventId: 1, UserId: testUserId, Status: models.RegistrationPending, RegisteredAt: registeredAt},
+	}, nil)
+
+	resp, err := handler.GetPending(context.Background(), &management.EventRequest{EventId: 1})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Registrations, 1)
+	assert.Equal(t, testUserId, resp.Registrations[0].UserId)
+	assert.Equal(t, models.RegistrationPending, resp.Registrations[0].Status)
+	assert.Equal(t, registeredAt, resp.Registrations[0].RegisteredAt.AsTime())
+	assert.Nil(t, resp.Registrations[0].CancelledAt)
+}
+
+func TestApprove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	tests := []struct {
+		name     string
+		userId   string
+		mock     func()
+		wantCode codes.Code
+	}{
+		{
+			name:   "success",
+			userId: testUserId,
+			mock: func() {
+				eventService.EXPECT().Approve(gomock.Any(), testUserId, 1).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:   "not pending",
+			userId: testUserId,
+			mock: func() {
+				eventService.EXPECT().Approve(gomock.Any(), testUserId, 1).Return(service.ErrNotPending)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:   "sold out",
+			userId: testUserId,
+			mock: func() {
+				eventService.EXPECT().Approve(gomock.Any(), testUserId, 1).Return(service.ErrSoldOut)
+			},
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "invalid user id",
+			userId:   "user",
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			_, err := handler.Approve(context.Background(), &management.RegistrationRequest{EventId: 1, UserId: tt.userId})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestGetRegistration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	cancelledAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	eventService.EXPECT().GetRegistration(gomock.Any(), testUserId, 1).Return(&models.Registration{
+		EventId:       1,
+		UserId:        testUserId,
+		Status:        models.RegistrationCancelled,
+		CancelledAt:   &cancelledAt,
+		Cancellations: 2,
+	}, nil)
+
+	resp, err := handler.GetRegistration(context.Background(), &management.RegistrationRequest{EventId: 1, UserId: testUserId})
+
+	require.NoError(t, err)
+	assert.Equal(t, models.RegistrationCancelled, resp.Status)
+	assert.Equal(t, cancelledAt, resp.CancelledAt.AsTime())
+	assert.Equal(t, int32(2), resp.Cancellations)
+}
@@ -0,0 +1,29 @@
+package event
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// OrganizedKey is the metadata key of a GetAllByCreator call that, when "true", also
+// lists the events the user co-organizes, which GetAllByCreatorRequest has no field for.
+const OrganizedKey = "x-organized"
+
+// organized reads the x-organized flag of a GetAllByCreator call, false when not sent.
+func organized(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	value, ok := first(md, OrganizedKey)
+	if !ok {
+		return false, nil
+	}
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, invalidMetadata(OrganizedKey, OrganizedKey+" must be true or false")
+	}
+	return flag, nil
+}
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrganized(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		want     bool
+		wantCode codes.Code
+	}{
+		{
+			name: "no metadata",
+			md:   nil,
+			want: false,
+		},
+		{
+			name: "not sent",
+			md:   metadata.Pairs("x-user-id", "ea27ecf4-02b1-453d-965d-408253a874b9"),
+			want: false,
+		},
+		{
+			name: "true",
+			md:   metadata.Pairs(OrganizedKey, "true"),
+			want: true,
+		},
+		{
+			name: "false",
+			md:   metadata.Pairs(OrganizedKey, " false "),
+			want: false,
+		},
+		{
+			name:     "not a bool",
+			md:       metadata.Pairs(OrganizedKey, "yes"),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			got, err := organized(ctx)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ManagementGRPCService) GetOrganizers(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.GetOrganizersResponse, error) {
+	organizers, err := s.eventService.GetOrganizers(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &management.GetOrganizersResponse{
+		Organizers: []*management.Organizer{},
+	}
+	for _, organizer := range organizers {
+		response.Organizers = append(response.Organizers, toOrganizer(organizer))
+	}
+	return response, nil
+}
+
+func (s *ManagementGRPCService) AddOrganizer(
+	ctx context.Context,
+	req *management.AddOrganizerRequest,
+) (*management.Organizer, error) {
+	organizer, err := s.eventService.AddOrganizer(ctx, int(req.EventId), req.UserId, req.Role)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toOrganizer(organizer), nil
+}
+
+func (s *ManagementGRPCService) RemoveOrganizer(
+	ctx context.Context,
+	req *management.OrganizerRequest,
+) (*management.EmptyResponse, error) {
+	err := s.eventService.RemoveOrganizer(ctx, int(req.EventId), req.UserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
+
+func (s *ManagementGRPCService) TransferOwnership(
+	ctx context.Context,
+	req *management.TransferOwnershipRequest,
+) (*management.EmptyResponse, error) {
+	err := s.eventService.TransferOwnership(ctx, int(req.EventId), req.UserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
+
+func toOrganizer(organizer *models.Organizer) *management.Organizer {
+	return &management.Organizer{
+		EventId: int64(organizer.EventId),
+		UserId:  organizer.UserId,
+		Role:    organizer.Role,
+		AddedAt: timestamppb.New(organizer.AddedAt),
+	}
+}
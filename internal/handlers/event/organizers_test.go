@@ -0,0 +1,82 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAddOrganizer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	addedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		organizer *models.Organizer
+		err       error
+		wantCode  codes.Code
+	}{
+		{
+			name:      "success",
+			organizer: &models.Organizer{EventId: 1, UserId: testUserId, Role: models.OrganizerEditor, AddedAt: addedAt},
+			wantCode:  codes.OK,
+		},
+		{
+			name:     "not the owner",
+			err:      service.ErrPermissionDenied,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "owner role",
+			err:      service.ErrOwnerRole,
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventService.EXPECT().AddOrganizer(gomock.Any(), 1, testUserId, models.OrganizerEditor).Return(tt.organizer, tt.err)
+
+			resp, err := handler.AddOrganizer(context.Background(), &management.AddOrganizerRequest{
+				EventId: 1,
+				UserId:  testUserId,
+				Role:    models.OrganizerEditor,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, models.OrganizerEditor, resp.Role)
+				assert.Equal(t, addedAt, resp.AddedAt.AsTime())
+			}
+		})
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	eventService.EXPECT().TransferOwnership(gomock.Any(), 1, "user").Return(service.ErrInvalidOrganizer)
+
+	_, err := handler.TransferOwnership(context.Background(), &management.TransferOwnershipRequest{EventId: 1, UserId: "user"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+)
+
+func (s *ManagementGRPCService) CreateQuestion(
+	ctx context.Context,
+	req *management.CreateQuestionRequest,
+) (*management.CreateQuestionResponse, error) {
+	id, err := s.eventService.CreateQuestion(ctx, &models.Question{
+		EventId:  int(req.EventId),
+		Position: int(req.Position),
+		Label:    req.Label,
+		Type:     req.Type,
+		Options:  req.Options,
+		Required: req.Required,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.CreateQuestionResponse{
+		Id: int64(id),
+	}, nil
+}
+
+func (s *ManagementGRPCService) GetQuestions(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.GetQuestionsResponse, error) {
+	questions, err := s.eventService.GetQuestions(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.GetQuestionsResponse{
+		Questions: toQuestions(questions),
+	}, nil
+}
+
+func (s *ManagementGRPCService) DeleteQuestion(
+	ctx context.Context,
+	req *management.DeleteQuestionRequest,
+) (*management.EmptyResponse, error) {
+	err := s.eventService.DeleteQuestion(ctx, int(req.EventId), int(req.Id))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
+
+func (s *ManagementGRPCService) ExportAnswers(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.ExportAnswersResponse, error) {
+	export, err := s.eventService.ExportAnswers(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &management.ExportAnswersResponse{
+		EventId:       int64(export.EventId),
+		Questions:     toQuestions(export.Questions),
+		Registrations: []*management.Registration{},
+	}
+	for _, registration := range export.Registrations {
+		response.Registrations = append(response.Registrations, toRegistration(registration))
+	}
+	return response, nil
+}
+
+func toQuestions(questions []*models.Question) []*management.Question {
+	result := []*management.Question{}
+	for _, question := range questions {
+		result = append(result, &management.Question{
+			Id:       int64(question.Id),
+			EventId:  int64(question.EventId),
+			Position: int32(question.Position),
+			Label:    question.Label,
+			Type:     question.Type,
+			Options:  question.Options,
+			Required: question.Required,
+		})
+	}
+	return result
+}
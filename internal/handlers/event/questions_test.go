@@ -0,0 +1,110 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateQuestion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "invalid options",
+			err:      service.ErrQuestionOptions,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventService.EXPECT().CreateQuestion(gomock.Any(), &models.Question{
+				EventId:  1,
+				Position: 1,
+				Label:    "Size",
+				Type:     models.QuestionSingleChoice,
+				Options:  []string{"S", "M"},
+				Required: true,
+			}).Return(3, tt.err)
+
+			resp, err := handler.CreateQuestion(context.Background(), &management.CreateQuestionRequest{
+				EventId:  1,
+				Position: 1,
+				Label:    "Size",
+				Type:     models.QuestionSingleChoice,
+				Options:  []string{"S", "M"},
+				Required: true,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, int64(3), resp.Id)
+			}
+		})
+	}
+}
+
+func TestExportAnswers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	eventService.EXPECT().ExportAnswers(gomock.Any(), 1).Return(&models.AnswerExport{
+		EventId: 1,
+		Questions: []*models.Question{
+			{Id: 3, EventId: 1, Label: "Size", Type: models.QuestionSingleChoice, Options: []string{"S", "M"}},
+		},
+		Registrations: []*models.Registration{
+			{EventId: 1, UserId: testUserId, Status: models.RegistrationActive, Answers: []models.Answer{{QuestionId: 3, Values: []string{"M"}}}},
+		},
+	}, nil)
+
+	resp, err := handler.ExportAnswers(context.Background(), &management.EventRequest{EventId: 1})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Questions, 1)
+	assert.Equal(t, "Size", resp.Questions[0].Label)
+	require.Len(t, resp.Registrations, 1)
+	require.Len(t, resp.Registrations[0].Answers, 1)
+	assert.Equal(t, int64(3), resp.Registrations[0].Answers[0].QuestionId)
+	assert.Equal(t, []string{"M"}, resp.Registrations[0].Answers[0].Values)
+}
+
+func TestDeleteQuestion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	eventService.EXPECT().DeleteQuestion(gomock.Any(), 1, 3).Return(service.ErrQuestionNotFound)
+
+	_, err := handler.DeleteQuestion(context.Background(), &management.DeleteQuestionRequest{EventId: 1, Id: 3})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
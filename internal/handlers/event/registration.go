@@ -0,0 +1,91 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys carrying the options of a Register call that RegisterRequest has no
+// fields for, e.g. x-ticket-type-id "3", x-guests "2", x-invite-code "abc" and
+// x-answers `[{"question_id":1,"values":["M"]}]`. Each one is optional.
+const (
+	TicketTypeIdKey = "x-ticket-type-id"
+	GuestsKey       = "x-guests"
+	AnswersKey      = "x-answers"
+	InviteCodeKey   = "x-invite-code"
+)
+
+// registerOptions are the options of a Register call, zero when not sent.
+type registerOptions struct {
+	ticketTypeId int
+	guests       int
+	answers      []models.Answer
+	code         string
+}
+
+// registrationOptions reads the options of a Register call from the metadata.
+func registrationOptions(ctx context.Context) (*registerOptions, error) {
+	options := &registerOptions{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return options, nil
+	}
+
+	var err error
+	if value, ok := first(md, TicketTypeIdKey); ok {
+		options.ticketTypeId, err = strconv.Atoi(value)
+		if err != nil || options.ticketTypeId <= 0 {
+			return nil, invalidMetadata(TicketTypeIdKey, TicketTypeIdKey+" must be a positive integer")
+		}
+	}
+	if value, ok := first(md, GuestsKey); ok {
+		options.guests, err = strconv.Atoi(value)
+		if err != nil || options.guests < 0 {
+			return nil, invalidMetadata(GuestsKey, GuestsKey+" must be a non-negative integer")
+		}
+	}
+	if value, ok := first(md, AnswersKey); ok {
+		if err := json.Unmarshal([]byte(value), &options.answers); err != nil {
+			return nil, invalidMetadata(AnswersKey, AnswersKey+` must be a JSON array of {"question_id", "values"}`)
+		}
+	}
+	if value, ok := first(md, InviteCodeKey); ok {
+		options.code = value
+	}
+	return options, nil
+}
+
+// first returns the first non-empty value of the metadata key.
+func first(md metadata.MD, key string) (string, bool) {
+	for _, value := range md.Get(key) {
+		if value = strings.TrimSpace(value); value != "" {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func invalidMetadata(key string, description string) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, description),
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       key,
+				Description: description,
+				Reason:      "INVALID_METADATA",
+			}},
+		},
+		&errdetails.ErrorInfo{
+			Reason: reasonInvalidArgument,
+			Domain: errorDomain,
+		},
+	)
+}
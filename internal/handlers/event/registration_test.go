@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegistrationOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		want     *registerOptions
+		wantCode codes.Code
+	}{
+		{
+			name: "no metadata",
+			md:   nil,
+			want: &registerOptions{},
+		},
+		{
+			name: "no options",
+			md:   metadata.Pairs("x-user-id", "ea27ecf4-02b1-453d-965d-408253a874b9"),
+			want: &registerOptions{},
+		},
+		{
+			name: "all options",
+			md: metadata.Pairs(
+				TicketTypeIdKey, "3",
+				GuestsKey, " 2 ",
+				AnswersKey, `[{"question_id":1,"values":["M"]}]`,
+				InviteCodeKey, "code",
+			),
+			want: &registerOptions{
+				ticketTypeId: 3,
+				guests:       2,
+				answers:      []models.Answer{{QuestionId: 1, Values: []string{"M"}}},
+				code:         "code",
+			},
+		},
+		{
+			name: "empty values are ignored",
+			md:   metadata.Pairs(TicketTypeIdKey, "", InviteCodeKey, " "),
+			want: &registerOptions{},
+		},
+		{
+			name:     "ticket type is not a number",
+			md:       metadata.Pairs(TicketTypeIdKey, "vip"),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "zero ticket type",
+			md:       metadata.Pairs(TicketTypeIdKey, "0"),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "negative guests",
+			md:       metadata.Pairs(GuestsKey, "-1"),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "answers are not JSON",
+			md:       metadata.Pairs(AnswersKey, "M"),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			options, err := registrationOptions(ctx)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.want, options)
+		})
+	}
+}
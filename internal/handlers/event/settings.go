@@ -0,0 +1,94 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+)
+
+// settingFields maps the settings of UpdateSettingsRequest to the fields of models.EventUpdateRequest.
+var settingFields = map[string]string{
+	models.FieldRequiresApproval:   "RequiresApproval",
+	models.FieldRegistrationOpens:  "RegistrationOpensAt",
+	models.FieldRegistrationCloses: "RegistrationClosesAt",
+	models.FieldCancellationCutoff: "CancellationCutoff",
+	models.FieldMaxGuests:          "MaxGuests",
+	models.FieldAllowTransfers:     "AllowTransfers",
+	models.FieldVisibility:         "Visibility",
+}
+
+// GetSettings returns the settings of an event with its version in the etag header.
+func (s *ManagementGRPCService) GetSettings(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.Settings, error) {
+	event, err := s.eventService.GetById(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	setETag(ctx, event.Version, 0)
+	return toSettings(event), nil
+}
+
+// UpdateSettings changes the settings set in the request and leaves the rest of the event as is.
+// Like Update it checks the version from if-match.
+func (s *ManagementGRPCService) UpdateSettings(
+	ctx context.Context,
+	req *management.UpdateSettingsRequest,
+) (*management.EmptyResponse, error) {
+	version, err := etagVersion(ctx, IfMatchKey)
+	if err != nil {
+		return nil, err
+	}
+	event_update := &models.EventUpdateRequest{
+		Id:      int(req.EventId),
+		Version: version,
+	}
+	if req.RequiresApproval != nil {
+		event_update.Fields = append(event_update.Fields, models.FieldRequiresApproval)
+		event_update.RequiresApproval = req.RequiresApproval
+	}
+	if req.RegistrationOpensAt != nil {
+		event_update.Fields = append(event_update.Fields, models.FieldRegistrationOpens)
+		event_update.RegistrationOpensAt = optionalTime(req.RegistrationOpensAt)
+	}
+	if req.RegistrationClosesAt != nil {
+		event_update.Fields = append(event_update.Fields, models.FieldRegistrationCloses)
+		event_update.RegistrationClosesAt = optionalTime(req.RegistrationClosesAt)
+	}
+	if req.CancellationCutoff != nil {
+		cutoff := req.CancellationCutoff.AsDuration()
+		event_update.Fields = append(event_update.Fields, models.FieldCancellationCutoff)
+		event_update.CancellationCutoff = &cutoff
+	}
+	if req.MaxGuests != nil {
+		maxGuests := int(*req.MaxGuests)
+		event_update.Fields = append(event_update.Fields, models.FieldMaxGuests)
+		event_update.MaxGuests = &maxGuests
+	}
+	if req.AllowTransfers != nil {
+		event_update.Fields = append(event_update.Fields, models.FieldAllowTransfers)
+		event_update.AllowTransfers = req.AllowTransfers
+	}
+	if req.Visibility != nil {
+		event_update.Fields = append(event_update.Fields, models.FieldVisibility)
+		event_update.Visibility = req.Visibility
+	}
+	if len(event_update.Fields) == 0 {
+		return nil, validationStatus(errors.New("no settings to update"), "")
+	}
+	fields := []string{"Id"}
+	for _, field := range event_update.Fields {
+		fields = append(fields, settingFields[field])
+	}
+	if err := s.validate.StructPartial(event_update, fields...); err != nil {
+		return nil, validationStatus(err, "")
+	}
+	err = s.eventService.Update(ctx, event_update)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
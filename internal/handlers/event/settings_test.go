@@ -0,0 +1,147 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	cutoff := 2 * time.Hour
+	eventService.EXPECT().GetById(gomock.Any(), 1).Return(&models.EventResponse{Id: 1, Version: 4, RequiresApproval: true, CancellationCutoff: &cutoff}, nil)
+
+	resp, err := handler.GetSettings(ctx, &management.EventRequest{EventId: 1})
+
+	require.NoError(t, err)
+	assert.True(t, resp.RequiresApproval)
+	assert.Nil(t, resp.RegistrationOpensAt)
+	assert.Equal(t, cutoff, resp.CancellationCutoff.AsDuration())
+	assert.Equal(t, []string{`"4"`}, stream.header.Get(ETagKey))
+}
+
+func TestUpdateSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	requiresApproval := true
+	closesAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cutoff := time.Hour
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		req      *management.UpdateSettingsRequest
+		mock     func()
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			md:   metadata.Pairs(IfMatchKey, `"3"`),
+			req:  &management.UpdateSettingsRequest{EventId: 1, RequiresApproval: &requiresApproval},
+			mock: func() {
+				eventService.EXPECT().Update(gomock.Any(), &models.EventUpdateRequest{
+					Id:               1,
+					Version:          3,
+					Fields:           []string{models.FieldRequiresApproval},
+					RequiresApproval: &requiresApproval,
+				}).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "registration window",
+			md:   metadata.Pairs(),
+			req: &management.UpdateSettingsRequest{
+				EventId:              1,
+				RegistrationClosesAt: timestamppb.New(closesAt),
+				CancellationCutoff:   durationpb.New(cutoff),
+			},
+			mock: func() {
+				eventService.EXPECT().Update(gomock.Any(), &models.EventUpdateRequest{
+					Id:                   1,
+					Fields:               []string{models.FieldRegistrationCloses, models.FieldCancellationCutoff},
+					RegistrationClosesAt: &closesAt,
+					CancellationCutoff:   &cutoff,
+				}).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "invalid registration window",
+			md:   metadata.Pairs(),
+			req:  &management.UpdateSettingsRequest{EventId: 1, RegistrationClosesAt: timestamppb.New(closesAt)},
+			mock: func() {
+				eventService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(service.ErrRegistrationWindow)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "negative max guests",
+			md:       metadata.Pairs(),
+			req:      &management.UpdateSettingsRequest{EventId: 1, MaxGuests: proto.Int32(-1)},
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "invalid visibility",
+			md:       metadata.Pairs(),
+			req:      &management.UpdateSettingsRequest{EventId: 1, Visibility: proto.String("hidden")},
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "nothing to update",
+			md:       metadata.Pairs(),
+			req:      &management.UpdateSettingsRequest{EventId: 1},
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "version mismatch",
+			md:   metadata.Pairs(IfMatchKey, `"2"`),
+			req:  &management.UpdateSettingsRequest{EventId: 1, RequiresApproval: &requiresApproval},
+			mock: func() {
+				eventService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(service.ErrVersionMismatch)
+			},
+			wantCode: codes.Aborted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			_, err := handler.UpdateSettings(ctx, tt.req)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
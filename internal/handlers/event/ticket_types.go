@@ -0,0 +1,54 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+)
+
+func (s *ManagementGRPCService) CreateTicketType(
+	ctx context.Context,
+	req *management.CreateTicketTypeRequest,
+) (*management.CreateTicketTypeResponse, error) {
+	id, err := s.eventService.CreateTicketType(ctx, &models.TicketType{
+		EventId:      int(req.EventId),
+		Name:         req.Name,
+		Capacity:     int(req.Capacity),
+		SaleStartsAt: optionalTime(req.SaleStartsAt),
+		SaleEndsAt:   optionalTime(req.SaleEndsAt),
+		Hidden:       req.Hidden,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.CreateTicketTypeResponse{
+		Id: int64(id),
+	}, nil
+}
+
+func (s *ManagementGRPCService) GetTicketTypes(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.GetTicketTypesResponse, error) {
+	ticketTypes, err := s.eventService.GetTicketTypes(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &management.GetTicketTypesResponse{
+		TicketTypes: []*management.TicketType{},
+	}
+	for _, ticketType := range ticketTypes {
+		response.TicketTypes = append(response.TicketTypes, &management.TicketType{
+			Id:           int64(ticketType.Id),
+			EventId:      int64(ticketType.EventId),
+			Name:         ticketType.Name,
+			Capacity:     int32(ticketType.Capacity),
+			Sold:         int32(ticketType.Sold),
+			SaleStartsAt: optionalTimestamp(ticketType.SaleStartsAt),
+			SaleEndsAt:   optionalTimestamp(ticketType.SaleEndsAt),
+			Hidden:       ticketType.Hidden,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateTicketType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	saleEndsAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "duplicate name",
+			err:      service.ErrTicketTypeExists,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "invalid capacity",
+			err:      service.ErrTicketTypeCapacity,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventService.EXPECT().CreateTicketType(gomock.Any(), &models.TicketType{
+				EventId:    1,
+				Name:       "VIP",
+				Capacity:   10,
+				SaleEndsAt: &saleEndsAt,
+				Hidden:     true,
+			}).Return(2, tt.err)
+
+			resp, err := handler.CreateTicketType(context.Background(), &management.CreateTicketTypeRequest{
+				EventId:    1,
+				Name:       "VIP",
+				Capacity:   10,
+				SaleEndsAt: timestamppb.New(saleEndsAt),
+				Hidden:     true,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, int64(2), resp.Id)
+			}
+		})
+	}
+}
+
+func TestGetTicketTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	eventService.EXPECT().GetTicketTypes(gomock.Any(), 1).Return([]*models.TicketType{
+		{Id: 2, EventId: 1, Name: "General", Capacity: 100, Sold: 5},
+	}, nil)
+
+	resp, err := handler.GetTicketTypes(context.Background(), &management.EventRequest{EventId: 1})
+
+	require.NoError(t, err)
+	require.Len(t, resp.TicketTypes, 1)
+	assert.Equal(t, "General", resp.TicketTypes[0].Name)
+	assert.Equal(t, int32(5), resp.TicketTypes[0].Sold)
+	assert.Nil(t, resp.TicketTypes[0].SaleStartsAt)
+}
@@ -0,0 +1,58 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+)
+
+func (s *ManagementGRPCService) GetTicket(
+	ctx context.Context,
+	req *management.GetTicketRequest,
+) (*management.Ticket, error) {
+	err := s.validate.Var(req.UserId, "uuid,required")
+	if err != nil {
+		return nil, validationStatus(err, "user_id")
+	}
+	ticket, err := s.eventService.GetTicket(ctx, req.UserId, int(req.EventId), req.Qr)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.Ticket{
+		EventId: int64(ticket.EventId),
+		UserId:  ticket.UserId,
+		Token:   ticket.Token,
+		Qr:      ticket.QR,
+	}, nil
+}
+
+func (s *ManagementGRPCService) CheckIn(
+	ctx context.Context,
+	req *management.CheckInRequest,
+) (*management.Registration, error) {
+	err := s.validate.Var(req.Token, "required")
+	if err != nil {
+		return nil, validationStatus(err, "token")
+	}
+	registration, err := s.eventService.CheckIn(ctx, req.Token)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toRegistration(registration), nil
+}
+
+func (s *ManagementGRPCService) GetAttendance(
+	ctx context.Context,
+	req *management.EventRequest,
+) (*management.Attendance, error) {
+	attendance, err := s.eventService.GetAttendance(ctx, int(req.EventId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.Attendance{
+		EventId:    int64(attendance.EventId),
+		Registered: int32(attendance.Registered),
+		CheckedIn:  int32(attendance.CheckedIn),
+		Guests:     int32(attendance.Guests),
+	}, nil
+}
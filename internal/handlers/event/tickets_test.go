@@ -0,0 +1,96 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTicket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	eventService.EXPECT().GetTicket(gomock.Any(), testUserId, 1, true).Return(&models.Ticket{
+		EventId: 1,
+		UserId:  testUserId,
+		Token:   "1." + testUserId + ".signature",
+		QR:      []byte("png"),
+	}, nil)
+
+	resp, err := handler.GetTicket(context.Background(), &management.GetTicketRequest{EventId: 1, UserId: testUserId, Qr: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1."+testUserId+".signature", resp.Token)
+	assert.Equal(t, []byte("png"), resp.Qr)
+}
+
+func TestCheckIn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	checkedInAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		token    string
+		mock     func()
+		wantCode codes.Code
+	}{
+		{
+			name:  "success",
+			token: "token",
+			mock: func() {
+				eventService.EXPECT().CheckIn(gomock.Any(), "token").Return(&models.Registration{
+					EventId:     1,
+					UserId:      testUserId,
+					Status:      models.RegistrationActive,
+					CheckedInAt: &checkedInAt,
+				}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:  "already checked in",
+			token: "token",
+			mock: func() {
+				eventService.EXPECT().CheckIn(gomock.Any(), "token").Return(nil, service.ErrCheckedIn)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "no token",
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			resp, err := handler.CheckIn(context.Background(), &management.CheckInRequest{Token: tt.token})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, checkedInAt, resp.CheckedInAt.AsTime())
+			}
+		})
+	}
+}
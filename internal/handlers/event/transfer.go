@@ -0,0 +1,22 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/api/management"
+)
+
+func (s *ManagementGRPCService) TransferRegistration(
+	ctx context.Context,
+	req *management.TransferRegistrationRequest,
+) (*management.EmptyResponse, error) {
+	err := s.validate.Var(req.FromUserId, "uuid,required")
+	if err != nil {
+		return nil, validationStatus(err, "from_user_id")
+	}
+	err = s.eventService.TransferRegistration(ctx, int(req.EventId), req.FromUserId, req.ToUserId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &management.EmptyResponse{}, nil
+}
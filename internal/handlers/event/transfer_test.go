@@ -0,0 +1,69 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/service/mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransferRegistration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eventService := mocks.NewMockIEventService(ctrl)
+	handler := &ManagementGRPCService{eventService: eventService, validate: validator.New()}
+
+	const toUserId = "5f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"
+
+	tests := []struct {
+		name     string
+		from     string
+		mock     func()
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			from: testUserId,
+			mock: func() {
+				eventService.EXPECT().TransferRegistration(gomock.Any(), 1, testUserId, toUserId).Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "transfers not allowed",
+			from: testUserId,
+			mock: func() {
+				eventService.EXPECT().TransferRegistration(gomock.Any(), 1, testUserId, toUserId).Return(service.ErrTransferNotAllowed)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "invalid sender",
+			from:     "user",
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			_, err := handler.TransferRegistration(context.Background(), &management.TransferRegistrationRequest{
+				EventId:    1,
+				FromUserId: tt.from,
+				ToUserId:   toUserId,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type Check struct {
+	Name string
+	Ping func(ctx context.Context) error
+}
+
+// Checker periodically pings the dependencies of the service and reports
+// SERVING only while all of them are reachable.
+type Checker struct {
+	server   *health.Server
+	services []string
+	checks   []Check
+	logger   *slog.Logger
+	config   *config.Health
+	stop     chan struct{}
+	once     sync.Once
+}
+
+func New(
+	server *health.Server,
+	services []string,
+	logger *slog.Logger,
+	config *config.Health,
+	checks ...Check,
+) *Checker {
+	c := &Checker{
+		server:   server,
+		services: append([]string{""}, services...),
+		checks:   checks,
+		logger:   logger,
+		config:   config,
+		stop:     make(chan struct{}),
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+func (c *Checker) Run() {
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+
+	c.check()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.check()
+		}
+	}
+}
+
+// Shutdown reports NOT_SERVING for all services and stops the checks.
+// Later status updates are ignored.
+func (c *Checker) Shutdown() {
+	c.once.Do(func() {
+		close(c.stop)
+		c.server.Shutdown()
+	})
+}
+
+func (c *Checker) check() {
+	status := healthpb.HealthCheckResponse_SERVING
+	for _, check := range c.checks {
+		ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+		err := check.Ping(ctx)
+		cancel()
+		if err != nil {
+			c.logger.Error(
+				"Health check failed",
+				slog.String("check", check.Name),
+				slog.String("err", err.Error()),
+			)
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	c.setStatus(status)
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
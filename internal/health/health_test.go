@@ -0,0 +1,71 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestChecker_Check(t *testing.T) {
+	ok := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return assert.AnError }
+
+	tests := []struct {
+		name     string
+		checks   []Check
+		shutdown bool
+		want     healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:   "all dependencies reachable",
+			checks: []Check{{Name: "postgres", Ping: ok}, {Name: "redis", Ping: ok}},
+			want:   healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name:   "postgres unreachable",
+			checks: []Check{{Name: "postgres", Ping: fail}, {Name: "redis", Ping: ok}},
+			want:   healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:   "redis unreachable",
+			checks: []Check{{Name: "postgres", Ping: ok}, {Name: "redis", Ping: fail}},
+			want:   healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name:     "shutting down",
+			checks:   []Check{{Name: "postgres", Ping: ok}, {Name: "redis", Ping: ok}},
+			shutdown: true,
+			want:     healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := health.NewServer()
+			checker := New(
+				server,
+				[]string{"event.Event"},
+				logger.GetLogger("test"),
+				&config.Health{Interval: time.Second, Timeout: time.Second},
+				tt.checks...,
+			)
+
+			if tt.shutdown {
+				checker.Shutdown()
+			}
+			checker.check()
+
+			for _, service := range []string{"", "event.Event"} {
+				resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, resp.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Auth puts the caller identity forwarded by the gateway in
+// x-user-id / x-user-role metadata into the request context.
+// The headers are only trusted from a peer with a client certificate verified
+// by mTLS, those of any other peer are dropped. A user id that is not a UUID
+// is rejected.
+func Auth() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if p, ok := peer.FromContext(ctx); !ok || !verifiedPeer(p) {
+			return handler(ctx, req)
+		}
+
+		userIds := md.Get(auth.UserIdKey)
+		if len(userIds) == 0 || userIds[0] == "" {
+			return handler(ctx, req)
+		}
+		if _, err := uuid.Parse(userIds[0]); err != nil {
+			return nil, status.Error(codes.Unauthenticated, auth.UserIdKey+" must be a UUID")
+		}
+
+		caller := &auth.Caller{UserId: userIds[0]}
+		if roles := md.Get(auth.RoleKey); len(roles) > 0 {
+			caller.Role = roles[0]
+		}
+
+		return handler(auth.WithCaller(ctx, caller), req)
+	}
+}
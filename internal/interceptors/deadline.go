@@ -0,0 +1,37 @@
+package interceptors
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"google.golang.org/grpc"
+)
+
+// Deadline sets the configured deadline on requests that came without one.
+// The context is passed down to the repositories, so slow queries are cancelled too.
+func Deadline(cfg *config.Deadlines) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if _, ok := ctx.Deadline(); ok {
+			return handler(ctx, req)
+		}
+
+		timeout, ok := cfg.Methods[strings.ToLower(path.Base(info.FullMethod))]
+		if !ok {
+			timeout = cfg.Default
+		}
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
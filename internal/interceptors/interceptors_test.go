@@ -0,0 +1,294 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecovery(t *testing.T) {
+	interceptor := Recovery(logger.GetLogger("test"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/event.Event/GetAll"}
+
+	tests := []struct {
+		name     string
+		handler  grpc.UnaryHandler
+		wantResp any
+		wantCode codes.Code
+	}{
+		{
+			name: "no panic",
+			handler: func(ctx context.Context, req any) (any, error) {
+				return "ok", nil
+			},
+			wantResp: "ok",
+			wantCode: codes.OK,
+		},
+		{
+			name: "error is passed through",
+			handler: func(ctx context.Context, req any) (any, error) {
+				return nil, status.Error(codes.NotFound, "not found")
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "panic",
+			handler: func(ctx context.Context, req any) (any, error) {
+				panic("boom")
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := interceptor(context.Background(), nil, info, tt.handler)
+
+			assert.Equal(t, tt.wantResp, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestRecovery_AfterLogging(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+	logging := Logging(log)
+	recovery := Recovery(log)
+	info := &grpc.UnaryServerInfo{FullMethod: "/event.Event/GetAll"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIdKey, "req-1"))
+
+	_, err := logging(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		return recovery(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			panic("boom")
+		})
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	for _, line := range lines {
+		assert.Contains(t, line, `"request_id":"req-1"`)
+	}
+	assert.Contains(t, lines[0], "Panic in handler")
+	assert.Contains(t, lines[1], "Request completed")
+}
+
+func TestAuth(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50051}
+	verified := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}}
+	userId := "5b3f6c1e-1d2a-4e5b-9c8d-7f6a5b4c3d2e"
+	interceptor := Auth()
+	info := &grpc.UnaryServerInfo{FullMethod: "/event.Event/DeleteById"}
+
+	tests := []struct {
+		name       string
+		peer       *peer.Peer
+		md         metadata.MD
+		wantCaller *auth.Caller
+		wantCode   codes.Code
+	}{
+		{
+			name:       "verified gateway",
+			peer:       &peer.Peer{Addr: addr, AuthInfo: verified},
+			md:         metadata.Pairs(auth.UserIdKey, userId, auth.RoleKey, auth.RoleAdmin),
+			wantCaller: &auth.Caller{UserId: userId, Role: auth.RoleAdmin},
+			wantCode:   codes.OK,
+		},
+		{
+			name:       "headers of an unverified peer are dropped",
+			peer:       &peer.Peer{Addr: addr},
+			md:         metadata.Pairs(auth.UserIdKey, userId, auth.RoleKey, auth.RoleAdmin),
+			wantCaller: nil,
+			wantCode:   codes.OK,
+		},
+		{
+			name:       "no user id",
+			peer:       &peer.Peer{Addr: addr, AuthInfo: verified},
+			md:         metadata.Pairs(auth.RoleKey, auth.RoleAdmin),
+			wantCaller: nil,
+			wantCode:   codes.OK,
+		},
+		{
+			name:     "user id is not a UUID",
+			peer:     &peer.Peer{Addr: addr, AuthInfo: verified},
+			md:       metadata.Pairs(auth.UserIdKey, "admin"),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(peer.NewContext(context.Background(), tt.peer), tt.md)
+			var gotCaller *auth.Caller
+
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+				gotCaller, _ = auth.CallerFromContext(ctx)
+				return nil, nil
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCaller, gotCaller)
+		})
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	interceptor := Deadline(&config.Deadlines{
+		Default: time.Second,
+		Methods: map[string]time.Duration{"getall": time.Minute},
+	})
+
+	tests := []struct {
+		name    string
+		method  string
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{name: "default", method: "/event.Event/GetById", want: time.Second},
+		{name: "per method", method: "/event.Event/GetAll", want: time.Minute},
+		{name: "client deadline is kept", method: "/event.Event/GetAll", timeout: time.Hour, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			_, _ = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req any) (any, error) {
+				deadline, ok := ctx.Deadline()
+				assert.True(t, ok)
+				assert.InDelta(t, tt.want.Seconds(), time.Until(deadline).Seconds(), 1)
+				return nil, nil
+			})
+		})
+	}
+}
+
+type stubLimiter struct {
+	keys    []string
+	allowed bool
+	err     error
+}
+
+func (l *stubLimiter) Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error) {
+	l.keys = append(l.keys, key)
+	return l.allowed, 1500 * time.Millisecond, l.err
+}
+
+func TestRateLimit(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50051}
+	verified := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}}
+	user := &auth.Caller{UserId: "5B3F6C1E-1D2A-4E5B-9C8D-7F6A5B4C3D2E"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/event.Event/Register"}
+	cfg := &config.RateLimit{
+		Enabled: true,
+		Default: config.Limit{Rate: 1, Burst: 1},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      *config.RateLimit
+		peer     *peer.Peer
+		caller   *auth.Caller
+		limiter  *stubLimiter
+		wantKeys []string
+		wantCode codes.Code
+	}{
+		{
+			name:     "disabled",
+			cfg:      &config.RateLimit{},
+			peer:     &peer.Peer{Addr: addr},
+			limiter:  &stubLimiter{},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "unlimited method",
+			cfg:      &config.RateLimit{Enabled: true, Methods: map[string]config.Limit{"register": {}}},
+			peer:     &peer.Peer{Addr: addr},
+			limiter:  &stubLimiter{},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "anonymous caller is keyed by ip",
+			cfg:      cfg,
+			peer:     &peer.Peer{Addr: addr},
+			limiter:  &stubLimiter{allowed: true},
+			wantKeys: []string{"register:ip:10.0.0.1"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "user id from an unverified peer is ignored",
+			cfg:      cfg,
+			peer:     &peer.Peer{Addr: addr},
+			caller:   user,
+			limiter:  &stubLimiter{allowed: true},
+			wantKeys: []string{"register:ip:10.0.0.1"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "user id from a verified peer",
+			cfg:      cfg,
+			peer:     &peer.Peer{Addr: addr, AuthInfo: verified},
+			caller:   user,
+			limiter:  &stubLimiter{allowed: true},
+			wantKeys: []string{"register:user:5b3f6c1e-1d2a-4e5b-9c8d-7f6a5b4c3d2e"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "limit exceeded",
+			cfg:      cfg,
+			peer:     &peer.Peer{Addr: addr},
+			limiter:  &stubLimiter{},
+			wantKeys: []string{"register:ip:10.0.0.1"},
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "limiter error lets the request through",
+			cfg:      cfg,
+			peer:     &peer.Peer{Addr: addr},
+			limiter:  &stubLimiter{err: errors.New("redis is down")},
+			wantKeys: []string{"register:ip:10.0.0.1"},
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), tt.peer)
+			if tt.caller != nil {
+				ctx = auth.WithCaller(ctx, tt.caller)
+			}
+			interceptor := RateLimit(tt.limiter, tt.cfg, logger.GetLogger("test"))
+
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+				return nil, nil
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantKeys, tt.limiter.keys)
+		})
+	}
+}
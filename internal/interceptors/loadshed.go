@@ -0,0 +1,49 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/loadshed"
+	"github.com/Estriper0/EventService/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// LoadShed rejects requests with Unavailable while the number of in-flight
+// requests is at the adaptive limit. Health checks are never shed.
+func LoadShed(limiter *loadshed.Limiter, log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if strings.HasPrefix(info.FullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+
+		if !limiter.Acquire() {
+			logger.FromContext(ctx, log).Warn(
+				"Request shed",
+				slog.Int("limit", limiter.Limit()),
+			)
+			return nil, status.Error(codes.Unavailable, "server is overloaded")
+		}
+
+		start := time.Now()
+		overloaded := true
+		defer func() {
+			limiter.Release(time.Since(start), overloaded)
+		}()
+
+		resp, err := handler(ctx, req)
+		overloaded = status.Code(err) == codes.DeadlineExceeded || ctx.Err() == context.DeadlineExceeded
+
+		return resp, err
+	}
+}
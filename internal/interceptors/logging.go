@@ -0,0 +1,72 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const RequestIdKey = "x-request-id"
+
+// Logging takes the request id from x-request-id metadata or generates a new one,
+// returns it in the response header and puts a logger enriched with the request
+// id, method and caller into the context. Every RPC is logged with its duration
+// and status code. Must run after Auth.
+func Logging(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		requestId := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if ids := md.Get(RequestIdKey); len(ids) > 0 {
+				requestId = ids[0]
+			}
+		}
+		if requestId == "" {
+			requestId = uuid.NewString()
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIdKey, requestId))
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestId))
+
+		l := log.With(
+			slog.String("request_id", requestId),
+			slog.String("method", info.FullMethod),
+		)
+		if caller, ok := auth.CallerFromContext(ctx); ok {
+			l = l.With(slog.String("caller", caller.UserId))
+		}
+		ctx = logger.WithLogger(ctx, l)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code := status.Code(err)
+
+		level := slog.LevelInfo
+		switch code {
+		case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+			level = slog.LevelError
+		}
+		l.Log(
+			ctx,
+			level,
+			"Request completed",
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(start)),
+		)
+
+		return resp, err
+	}
+}
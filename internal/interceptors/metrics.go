@@ -0,0 +1,31 @@
+package interceptors
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics records the number and latency of requests per method and status code.
+func Metrics() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		method := path.Base(info.FullMethod)
+		code := status.Code(err).String()
+		metrics.RequestsTotal.WithLabelValues(method, code).Inc()
+		metrics.RequestDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
@@ -0,0 +1,96 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const RetryAfterKey = "retry-after"
+
+// RateLimit applies a token bucket per method and caller. Callers forwarded by an
+// authenticated peer are keyed by user id, all others by peer IP. Must run after Auth.
+func RateLimit(limiter ratelimit.Limiter, cfg *config.RateLimit, log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !cfg.Enabled {
+			return handler(ctx, req)
+		}
+
+		method := strings.ToLower(path.Base(info.FullMethod))
+		limit, ok := cfg.Methods[method]
+		if !ok {
+			limit = cfg.Default
+		}
+		if limit.Rate <= 0 || limit.Burst <= 0 {
+			return handler(ctx, req)
+		}
+
+		key := method + ":" + callerKey(ctx)
+		allowed, retryAfter, err := limiter.Allow(ctx, key, limit)
+		if err != nil {
+			logger.FromContext(ctx, log).Error(
+				"Error in rate limiter",
+				slog.String("key", key),
+				slog.String("err", err.Error()),
+			)
+			return handler(ctx, req)
+		}
+		if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			if seconds < 1 {
+				seconds = 1
+			}
+			_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterKey, strconv.Itoa(seconds)))
+			logger.FromContext(ctx, log).Info(
+				"Rate limit exceeded",
+				slog.String("key", key),
+			)
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// callerKey returns the bucket key of the caller. The user id comes from metadata,
+// so any client could get a fresh bucket by changing it. It is only used when the
+// peer presented a verified client certificate, i.e. it is the gateway under mTLS.
+func callerKey(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if caller, authenticated := auth.CallerFromContext(ctx); authenticated && ok && verifiedPeer(p) {
+		return "user:" + strings.ToLower(caller.UserId)
+	}
+	if ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		return "ip:" + host
+	}
+	return "unknown"
+}
+
+func verifiedPeer(p *peer.Peer) bool {
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	return ok && len(info.State.VerifiedChains) > 0
+}
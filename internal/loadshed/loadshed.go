@@ -0,0 +1,68 @@
+package loadshed
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+)
+
+// Limiter bounds the number of in-flight requests. The limit grows by one
+// per window of requests finished under the target latency and shrinks
+// multiplicatively when requests are slower, so the service backs off as
+// soon as its dependencies degrade (AIMD).
+type Limiter struct {
+	mu       sync.Mutex
+	config   *config.LoadShedding
+	limit    float64
+	inflight int
+}
+
+const backoff = 0.9
+
+func New(config *config.LoadShedding) *Limiter {
+	return &Limiter{
+		config: config,
+		limit:  float64(config.InitialLimit),
+	}
+}
+
+// Acquire reserves a slot for a request. It returns false when the service
+// is at its limit and the request must be rejected.
+func (l *Limiter) Acquire() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.inflight >= int(l.limit) {
+		return false
+	}
+	l.inflight++
+	return true
+}
+
+// Release frees the slot and adjusts the limit by the request latency.
+// Requests that timed out or panicked count as slow.
+func (l *Limiter) Release(latency time.Duration, overloaded bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inflight--
+	if overloaded || latency > l.config.TargetLatency {
+		l.limit = math.Max(float64(l.config.MinLimit), l.limit*backoff)
+	} else {
+		l.limit = math.Min(float64(l.config.MaxLimit), l.limit+1/l.limit)
+	}
+}
+
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+func (l *Limiter) Inflight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inflight
+}
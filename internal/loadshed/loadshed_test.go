@@ -0,0 +1,105 @@
+package loadshed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	cfg := &config.LoadShedding{
+		InitialLimit:  2,
+		MinLimit:      1,
+		MaxLimit:      3,
+		TargetLatency: 100 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name      string
+		run       func(l *Limiter)
+		wantOk    bool
+		wantLimit int
+	}{
+		{
+			name:      "under the limit",
+			run:       func(l *Limiter) { l.Acquire() },
+			wantOk:    true,
+			wantLimit: 2,
+		},
+		{
+			name: "at the limit",
+			run: func(l *Limiter) {
+				l.Acquire()
+				l.Acquire()
+			},
+			wantOk:    false,
+			wantLimit: 2,
+		},
+		{
+			name: "fast requests raise the limit",
+			run: func(l *Limiter) {
+				for i := 0; i < 4; i++ {
+					l.Acquire()
+					l.Release(10*time.Millisecond, false)
+				}
+				l.Acquire()
+				l.Acquire()
+			},
+			wantOk:    true,
+			wantLimit: 3,
+		},
+		{
+			name: "limit is capped",
+			run: func(l *Limiter) {
+				for i := 0; i < 100; i++ {
+					l.Acquire()
+					l.Release(10*time.Millisecond, false)
+				}
+			},
+			wantOk:    true,
+			wantLimit: 3,
+		},
+		{
+			name: "slow requests lower the limit",
+			run: func(l *Limiter) {
+				l.Acquire()
+				l.Release(time.Second, false)
+				l.Acquire()
+			},
+			wantOk:    false,
+			wantLimit: 1,
+		},
+		{
+			name: "timed out requests lower the limit",
+			run: func(l *Limiter) {
+				l.Acquire()
+				l.Release(10*time.Millisecond, true)
+			},
+			wantOk:    true,
+			wantLimit: 1,
+		},
+		{
+			name: "limit is not lower than the minimum",
+			run: func(l *Limiter) {
+				for i := 0; i < 100; i++ {
+					l.Acquire()
+					l.Release(time.Second, false)
+				}
+			},
+			wantOk:    true,
+			wantLimit: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(cfg)
+			tt.run(l)
+
+			assert.Equal(t, tt.wantOk, l.Acquire())
+			assert.Equal(t, tt.wantLimit, l.Limit())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"log/slog"
 	"os"
 	"time"
@@ -41,3 +42,27 @@ func GetLogger(env string) *slog.Logger {
 	}
 	return nil
 }
+
+type loggerKey struct{}
+
+// WithLogger stores a request-scoped logger in the context.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the request-scoped logger or fallback if there is none.
+func FromContext(ctx context.Context, fallback *slog.Logger) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok && logger != nil {
+		return logger
+	}
+	return fallback
+}
+
+// Query logs the query a repository is about to run at debug level with the
+// request-scoped logger. Outside of a request nothing is logged, the span of the
+// query records it anyway.
+func Query(ctx context.Context, query string) {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok && logger != nil {
+		logger.DebugContext(ctx, "Executing query", slog.String("query", query))
+	}
+}
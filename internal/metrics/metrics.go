@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "event_service"
+
+const (
+	CacheHit   = "hit"
+	CacheMiss  = "miss"
+	CacheError = "error"
+)
+
+var (
+	RequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_total",
+			Help:      "Total number of gRPC requests by method and status code.",
+		},
+		[]string{"method", "code"},
+	)
+	RequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "gRPC request latency by method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+	CacheRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_requests_total",
+			Help:      "Event cache lookups by result (hit, miss, error).",
+		},
+		[]string{"result"},
+	)
+	RegistrationsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "registrations_total",
+			Help:      "Total number of successful registrations to events.",
+		},
+	)
+	CancellationsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "registration_cancellations_total",
+			Help:      "Total number of cancelled registrations.",
+		},
+	)
+	CheckInsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "check_ins_total",
+			Help:      "Total number of tickets checked in at events.",
+		},
+	)
+
+	registrations = newWindow()
+	_             = promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "registrations_per_minute",
+			Help:      "Number of successful registrations during the last minute.",
+		},
+		func() float64 { return float64(registrations.Count()) },
+	)
+)
+
+// Registered records a successful registration.
+func Registered() {
+	RegistrationsTotal.Inc()
+	registrations.Add()
+}
+
+// Cancelled records a cancelled registration.
+func Cancelled() {
+	CancellationsTotal.Inc()
+}
+
+// CheckedIn records a ticket checked in at an event.
+func CheckedIn() {
+	CheckInsTotal.Inc()
+}
+
+// RegisterDB exports the connection pool stats of db.
+func RegisterDB(db *sql.DB, dbName string) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+const windowSize = 60
+
+// window counts events over the last minute in one-second slots.
+type window struct {
+	mu    sync.Mutex
+	slots [windowSize]int
+	times [windowSize]int64
+	now   func() time.Time
+}
+
+func newWindow() *window {
+	return &window{now: time.Now}
+}
+
+func (w *window) Add() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sec := w.now().Unix()
+	i := sec % windowSize
+	if w.times[i] != sec {
+		w.times[i] = sec
+		w.slots[i] = 0
+	}
+	w.slots[i]++
+}
+
+func (w *window) Count() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sec := w.now().Unix()
+	count := 0
+	for i := range w.slots {
+		if sec-w.times[i] < windowSize {
+			count += w.slots[i]
+		}
+	}
+	return count
+}
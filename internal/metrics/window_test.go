@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindow_Count(t *testing.T) {
+	now := time.Date(2025, 11, 10, 14, 0, 0, 0, time.UTC)
+	w := newWindow()
+	w.now = func() time.Time { return now }
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		adds    int
+		want    int
+	}{
+		{name: "empty", want: 0},
+		{name: "same second", adds: 3, want: 3},
+		{name: "later in the minute", advance: 30 * time.Second, adds: 2, want: 5},
+		{name: "first slot expired", advance: 30 * time.Second, want: 2},
+		{name: "all slots expired", advance: time.Minute, want: 0},
+		{name: "slot reused after a minute", adds: 1, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.advance)
+			for i := 0; i < tt.adds; i++ {
+				w.Add()
+			}
+
+			assert.Equal(t, tt.want, w.Count())
+		})
+	}
+}
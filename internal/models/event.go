@@ -1,9 +1,28 @@
 package models
 
 import (
+	"slices"
 	"time"
 )
 
+// Updatable fields of an event, named as in UpdateRequest and as the table columns.
+const (
+	FieldTitle              string = "title"
+	FieldAbout              string = "about"
+	FieldStartDate          string = "start_date"
+	FieldLocation           string = "location"
+	FieldStatus             string = "status"
+	FieldMaxAttendees       string = "max_attendees"
+	FieldRequiresApproval   string = "requires_approval"
+	FieldRegistrationOpens  string = "registration_opens_at"
+	FieldRegistrationCloses string = "registration_closes_at"
+	// FieldCancellationCutoff is stored in whole seconds.
+	FieldCancellationCutoff string = "cancellation_cutoff"
+	FieldMaxGuests          string = "max_guests"
+	FieldAllowTransfers     string = "allow_transfers"
+	FieldVisibility         string = "visibility"
+)
+
 const (
 	StatusDraft     string = "draft"
 	StatusPublished string = "published"
@@ -13,6 +32,14 @@ const (
 	StatusPostponed string = "postponed"
 )
 
+// Visibility levels of an event. An unlisted event is only left out of the lists,
+// a private one is also hidden from everyone but its creator and invited users.
+const (
+	VisibilityPublic   string = "public"
+	VisibilityUnlisted string = "unlisted"
+	VisibilityPrivate  string = "private"
+)
+
 type EventUpdateRequest struct {
 	Id           int    `validate:"required"`
 	Title        string `validate:"min=5,max=255"`
@@ -21,6 +48,27 @@ type EventUpdateRequest struct {
 	Location     string
 	Status       string `validate:"oneof=draft published ongoing completed cancelled postponed"`
 	MaxAttendees int    `validate:"min=5,max=1000"`
+	// Version is the expected version of the event. Zero disables the check.
+	Version int
+	// Fields limits the update to the listed fields. All fields are updated when it is empty.
+	Fields []string
+	// RequiresApproval switches the moderation of registrations, it is left unchanged when nil.
+	RequiresApproval *bool
+	// The registration window and the cancellation cut-off are left unchanged when nil.
+	RegistrationOpensAt  *time.Time
+	RegistrationClosesAt *time.Time
+	CancellationCutoff   *time.Duration
+	// MaxGuests is left unchanged when nil.
+	MaxGuests *int `validate:"omitempty,min=0"`
+	// AllowTransfers is left unchanged when nil.
+	AllowTransfers *bool
+	// Visibility is left unchanged when nil.
+	Visibility *string `validate:"omitempty,oneof=public unlisted private"`
+}
+
+// Has reports whether the field is part of the update.
+func (r *EventUpdateRequest) Has(field string) bool {
+	return len(r.Fields) == 0 || slices.Contains(r.Fields, field)
 }
 
 type EventCreateRequest struct {
@@ -31,6 +79,19 @@ type EventCreateRequest struct {
 	Status       string    `validate:"required,oneof=draft published ongoing completed cancelled postponed"`
 	MaxAttendees int       `validate:"required,min=5,max=1000"`
 	Creator      string    `validate:"required,uuid"`
+	// RequiresApproval makes registrations pending until the creator approves them.
+	RequiresApproval bool
+	// RegistrationOpensAt, RegistrationClosesAt and CancellationCutoff fall back
+	// to the defaults from config.Registration when nil.
+	RegistrationOpensAt  *time.Time
+	RegistrationClosesAt *time.Time
+	CancellationCutoff   *time.Duration
+	// MaxGuests is how many guests a registration may bring, zero allows none.
+	MaxGuests int `validate:"min=0"`
+	// AllowTransfers lets users hand their registration over to another user.
+	AllowTransfers bool
+	// Visibility defaults to public when empty.
+	Visibility string `validate:"omitempty,oneof=public unlisted private"`
 }
 
 type EventResponse struct {
@@ -43,4 +104,16 @@ type EventResponse struct {
 	MaxAttendees      int
 	CurrentAttendance int
 	Creator           string
-}
\ No newline at end of file
+	Version           int
+	// DeletedAt is set for deleted events until they are purged.
+	DeletedAt        *time.Time
+	RequiresApproval bool
+
+	RegistrationOpensAt  *time.Time
+	RegistrationClosesAt *time.Time
+	// CancellationCutoff is how long before the start registrations can no longer be cancelled.
+	CancellationCutoff *time.Duration
+	MaxGuests          int
+	AllowTransfers     bool
+	Visibility         string
+}
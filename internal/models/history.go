@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+const (
+	ActionCreate       string = "create"
+	ActionUpdate       string = "update"
+	ActionStatusChange string = "status_change"
+	ActionDelete       string = "delete"
+	ActionRestore      string = "restore"
+	ActionTransfer     string = "ownership_transfer"
+)
+
+// FieldCreator is the field changed when the ownership of an event is transferred.
+const FieldCreator string = "creator"
+
+// FieldChange is the old and the new value of a changed field.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// EventHistory is a revision of an event recorded on every write.
+// Changes is keyed by the field names from Field* constants and
+// Snapshot is the event as it looked after the write.
+type EventHistory struct {
+	Id        int
+	EventId   int
+	Revision  int
+	Action    string
+	Actor     string
+	ChangedAt time.Time
+	Changes   map[string]FieldChange
+	Snapshot  *EventResponse
+}
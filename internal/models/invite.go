@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Invite lets a user register for a private event by presenting its code. A personal
+// invite is bound to one user, who also sees the event before registering; any
+// other invite can be shared as a link and used by whoever has the code.
+type Invite struct {
+	Code    string
+	EventId int
+	// UserId is empty for an invite that can be shared.
+	UserId    string
+	CreatedBy string
+	CreatedAt time.Time
+	// RevokedAt is set once the invite can no longer be used.
+	RevokedAt *time.Time
+}
+
+// Viewer is the user a list of events is for. Unlisted and private events are
+// only listed to their creator, to invited users and to registered users.
+type Viewer struct {
+	// UserId is empty for an anonymous caller.
+	UserId string
+}
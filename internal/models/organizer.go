@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Roles of the organizers of an event, from the most to the least privileged.
+// The owner is the creator of the event and manages its organizers, an editor
+// changes the event and its registrations, check-in staff check tickets in and
+// a viewer only sees the event with its attendees.
+const (
+	OrganizerOwner   string = "owner"
+	OrganizerEditor  string = "editor"
+	OrganizerCheckIn string = "checkin"
+	OrganizerViewer  string = "viewer"
+)
+
+type Organizer struct {
+	EventId int
+	UserId  string
+	Role    string
+	AddedAt time.Time
+}
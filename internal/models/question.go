@@ -0,0 +1,36 @@
+package models
+
+// Types of registration questions.
+const (
+	QuestionText         string = "text"
+	QuestionSingleChoice string = "single_choice"
+	QuestionMultiChoice  string = "multi_choice"
+	QuestionBoolean      string = "boolean"
+)
+
+// Question is a question of the registration form of an event. Options are
+// the choices of a single or multi choice question.
+type Question struct {
+	Id       int
+	EventId  int
+	Position int
+	Label    string
+	Type     string
+	Options  []string
+	Required bool
+}
+
+// Answer is the answer of a registration to a question: the text, the chosen
+// options or "true" or "false" for a boolean question.
+type Answer struct {
+	QuestionId int      `json:"question_id"`
+	Values     []string `json:"values"`
+}
+
+// AnswerExport is the registration form of an event with the answers of its
+// active and pending registrations.
+type AnswerExport struct {
+	EventId       int
+	Questions     []*Question
+	Registrations []*Registration
+}
@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+const (
+	RegistrationActive    string = "active"
+	RegistrationCancelled string = "cancelled"
+	// RegistrationPending waits for the creator of a moderated event and takes no seat.
+	RegistrationPending  string = "pending"
+	RegistrationRejected string = "rejected"
+)
+
+// Registration is a row of event_user. A cancelled registration is kept
+// and becomes active again when the user registers once more.
+type Registration struct {
+	EventId       int
+	UserId        string
+	Status        string
+	RegisteredAt  time.Time
+	CancelledAt   *time.Time
+	Cancellations int
+	// TicketTypeId is the ticket type of the registration, zero when the event has none.
+	TicketTypeId int
+	// CheckedInAt is set when the ticket is checked in at the door.
+	CheckedInAt *time.Time
+	// Guests is the number of people the user brings, each of them takes a seat.
+	Guests int
+	// Answers are the answers to the registration form of the event.
+	Answers []Answer
+}
+
+// Seats is the number of seats the registration takes, the user and the guests.
+func (r *Registration) Seats() int {
+	return 1 + r.Guests
+}
+
+// Ticket is the signed token of an active registration, QR holds it as a PNG when requested.
+type Ticket struct {
+	EventId int
+	UserId  string
+	Token   string
+	QR      []byte
+}
+
+// Attendance counts the active registrations of an event, how many of them are checked in
+// and the guests they bring.
+type Attendance struct {
+	EventId    int
+	Registered int
+	CheckedIn  int
+	Guests     int
+}
+
+// EventRegistration is an event together with the registration of a user.
+type EventRegistration struct {
+	Event        *EventResponse
+	Registration *Registration
+}
+
+// Outcomes of a user in a group registration.
+const (
+	GroupRegistered string = "registered"
+	GroupPending    string = "pending"
+	GroupSkipped    string = "skipped"
+	GroupFailed     string = "failed"
+)
+
+// GroupOutcome is the outcome of a group registration for one of its users.
+// Err holds why the user was skipped or failed, it is nil for a user skipped
+// by an all-or-none registration.
+type GroupOutcome struct {
+	UserId  string
+	Outcome string
+	Err     error
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// TicketType is a kind of ticket of an event with its own capacity and sale window.
+// A hidden ticket type is not listed publicly but can be registered for by its id.
+type TicketType struct {
+	Id       int
+	EventId  int
+	Name     string
+	Capacity int
+	Sold     int
+	// SaleStartsAt and SaleEndsAt bound the sale window, a nil bound is open.
+	SaleStartsAt *time.Time
+	SaleEndsAt   *time.Time
+	Hidden       bool
+}
+
+// OnSale reports whether the ticket type can be registered for at the given time.
+func (t *TicketType) OnSale(now time.Time) bool {
+	if t.SaleStartsAt != nil && now.Before(*t.SaleStartsAt) {
+		return false
+	}
+	if t.SaleEndsAt != nil && !now.Before(*t.SaleEndsAt) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package purge
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/repositories"
+)
+
+// Job periodically removes events that were deleted longer than the
+// retention period ago.
+type Job struct {
+	repo   repositories.IEventRepository
+	logger *slog.Logger
+	config *config.Retention
+	now    func() time.Time
+	stop   chan struct{}
+	once   sync.Once
+}
+
+func New(repo repositories.IEventRepository, logger *slog.Logger, config *config.Retention) *Job {
+	return &Job{
+		repo:   repo,
+		logger: logger,
+		config: config,
+		now:    time.Now,
+		stop:   make(chan struct{}),
+	}
+}
+
+func (j *Job) Run() {
+	ticker := time.NewTicker(j.config.PurgeInterval)
+	defer ticker.Stop()
+
+	j.purge()
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-ticker.C:
+			j.purge()
+		}
+	}
+}
+
+func (j *Job) Stop() {
+	j.once.Do(func() {
+		close(j.stop)
+	})
+}
+
+func (j *Job) purge() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.config.PurgeInterval)
+	defer cancel()
+
+	before := j.now().Add(-j.config.PurgeAfter)
+	count, err := j.repo.Purge(ctx, before)
+	if err != nil {
+		j.logger.Error(
+			"Error purging deleted events",
+			slog.String("err", err.Error()),
+		)
+		return
+	}
+	if count > 0 {
+		j.logger.Info(
+			"Purged deleted events",
+			slog.Int64("count", count),
+			slog.Time("deleted_before", before),
+		)
+	}
+}
@@ -0,0 +1,65 @@
+package purge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/repositories/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJob_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &config.Retention{PurgeAfter: 30 * 24 * time.Hour, PurgeInterval: time.Hour}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "success"},
+		{name: "repository error", err: assert.AnError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockIEventRepository(ctrl)
+			job := New(repo, logger.GetLogger("test"), cfg)
+			job.now = func() time.Time { return now }
+
+			repo.EXPECT().
+				Purge(gomock.Any(), now.Add(-cfg.PurgeAfter)).
+				Return(int64(2), tt.err)
+
+			job.purge()
+		})
+	}
+}
+
+func TestJob_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockIEventRepository(ctrl)
+	repo.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(int64(0), nil).MinTimes(1)
+	job := New(repo, logger.GetLogger("test"), &config.Retention{PurgeAfter: time.Hour, PurgeInterval: time.Hour})
+
+	done := make(chan struct{})
+	go func() {
+		job.Run()
+		close(done)
+	}()
+	job.Stop()
+	job.Stop()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job did not stop")
+	}
+}
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+)
+
+const sweepThreshold = 10000
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// idle is the time the bucket takes to fill up with the limit it was last
+	// used with, it can be dropped once it has been idle for longer.
+	idle time.Duration
+}
+
+type memoryLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func New() *memoryLimiter {
+	return &memoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow implements ratelimit.Limiter. A limit without a positive rate and burst
+// does not limit, like in the RateLimit interceptor.
+func (l *memoryLimiter) Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return true, 0, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if len(l.buckets) >= sweepThreshold {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+		b.last = now
+	}
+	b.idle = time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second))
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+
+	retryAfter := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return false, retryAfter, nil
+}
+
+// sweep drops buckets that have been idle long enough to be full again, each
+// with the limit of its own key.
+func (l *memoryLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if now.Sub(b.last) > b.idle {
+			delete(l.buckets, key)
+		}
+	}
+}
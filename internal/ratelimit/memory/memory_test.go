@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	limit := config.Limit{Rate: 1, Burst: 2}
+
+	tests := []struct {
+		name           string
+		key            string
+		advance        time.Duration
+		wantAllowed    bool
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:        "first token of the burst",
+			key:         "a",
+			wantAllowed: true,
+		},
+		{
+			name:        "second token of the burst",
+			key:         "a",
+			wantAllowed: true,
+		},
+		{
+			name:           "bucket is empty",
+			key:            "a",
+			wantAllowed:    false,
+			wantRetryAfter: time.Second,
+		},
+		{
+			name:        "other key has its own bucket",
+			key:         "b",
+			wantAllowed: true,
+		},
+		{
+			name:           "partially refilled",
+			key:            "a",
+			advance:        500 * time.Millisecond,
+			wantAllowed:    false,
+			wantRetryAfter: 500 * time.Millisecond,
+		},
+		{
+			name:        "refilled",
+			key:         "a",
+			advance:     500 * time.Millisecond,
+			wantAllowed: true,
+		},
+	}
+
+	now := time.Date(2025, 11, 10, 14, 0, 0, 0, time.UTC)
+	limiter := New()
+	limiter.now = func() time.Time { return now }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.advance)
+
+			allowed, retryAfter, err := limiter.Allow(ctx, tt.key, limit)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.Equal(t, tt.wantRetryAfter, retryAfter)
+		})
+	}
+}
+
+func TestMemoryLimiter_NoLimit(t *testing.T) {
+	limiter := New()
+
+	for _, limit := range []config.Limit{{Rate: 0, Burst: 2}, {Rate: 1, Burst: 0}} {
+		allowed, retryAfter, err := limiter.Allow(context.Background(), "a", limit)
+
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Zero(t, retryAfter)
+	}
+	assert.Empty(t, limiter.buckets)
+}
+
+func TestMemoryLimiter_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 11, 10, 14, 0, 0, 0, time.UTC)
+	limiter := New()
+	limiter.now = func() time.Time { return now }
+
+	_, _, err := limiter.Allow(ctx, "fast", config.Limit{Rate: 10, Burst: 1})
+	assert.NoError(t, err)
+	_, _, err = limiter.Allow(ctx, "slow", config.Limit{Rate: 0.1, Burst: 1})
+	assert.NoError(t, err)
+
+	limiter.sweep(now.Add(time.Second))
+
+	assert.NotContains(t, limiter.buckets, "fast")
+	assert.Contains(t, limiter.buckets, "slow")
+}
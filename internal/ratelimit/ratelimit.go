@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+)
+
+type Limiter interface {
+	// Allow takes one token from the bucket identified by key. When the bucket
+	// is empty it returns false and the time until the next token is available.
+	Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error)
+}
+
+type fallbackLimiter struct {
+	primary  Limiter
+	fallback Limiter
+	logger   *slog.Logger
+}
+
+// WithFallback returns a limiter that uses fallback whenever primary fails,
+// so that an unavailable Redis does not reject or block requests.
+func WithFallback(primary Limiter, fallback Limiter, logger *slog.Logger) Limiter {
+	return &fallbackLimiter{
+		primary:  primary,
+		fallback: fallback,
+		logger:   logger,
+	}
+}
+
+func (l *fallbackLimiter) Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error) {
+	allowed, retryAfter, err := l.primary.Allow(ctx, key, limit)
+	if err == nil {
+		return allowed, retryAfter, nil
+	}
+	l.logger.Warn(
+		"Error in rate limiter, using fallback",
+		slog.String("key", key),
+		slog.String("err", err.Error()),
+	)
+	return l.fallback.Allow(ctx, key, limit)
+}
@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucket refills the bucket stored in a hash by the time elapsed since the
+// last call and takes one token from it. Redis server time is used so that all
+// replicas share the same clock.
+var tokenBucket = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local data = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(data[1])
+local ts = tonumber(data[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, retry}
+`)
+
+type redisLimiter struct {
+	client *redis.Client
+}
+
+func New(client *redis.Client) *redisLimiter {
+	return &redisLimiter{
+		client: client,
+	}
+}
+
+// Allow implements ratelimit.Limiter. A limit without a positive rate and burst
+// does not limit, like in the RateLimit interceptor.
+func (l *redisLimiter) Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return true, 0, nil
+	}
+	res, err := tokenBucket.Run(ctx, l.client, []string{"ratelimit:" + key}, limit.Rate, limit.Burst).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
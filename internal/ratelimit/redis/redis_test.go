@@ -0,0 +1,75 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func TestRedisLimiter_Allow(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	container, err := testcontainers.Run(ctx,
+		"redis:8.2-alpine",
+		testcontainers.WithExposedPorts("6379/tcp"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("Ready to accept connections").
+				WithStartupTimeout(30*time.Second),
+		),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	addr, err := container.PortEndpoint(ctx, "6379/tcp", "")
+	require.NoError(t, err)
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { _ = client.Close() })
+
+	limiter := New(client)
+	limit := config.Limit{Rate: 2, Burst: 2}
+
+	for i := 0; i < limit.Burst; i++ {
+		allowed, _, err := limiter.Allow(ctx, "a", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+
+	allowed, retryAfter, err := limiter.Allow(ctx, "a", limit)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Greater(t, retryAfter, time.Duration(0))
+	assert.LessOrEqual(t, retryAfter, 500*time.Millisecond)
+
+	allowed, _, err = limiter.Allow(ctx, "b", limit)
+	require.NoError(t, err)
+	assert.True(t, allowed, "other key has its own bucket")
+
+	ttl, err := client.PTTL(ctx, "ratelimit:a").Result()
+	require.NoError(t, err)
+	assert.Greater(t, ttl, time.Duration(0), "bucket expires")
+
+	time.Sleep(retryAfter + 50*time.Millisecond)
+	allowed, _, err = limiter.Allow(ctx, "a", limit)
+	require.NoError(t, err)
+	assert.True(t, allowed, "bucket is refilled")
+}
+
+func TestRedisLimiter_NoLimit(t *testing.T) {
+	limiter := New(nil)
+
+	allowed, retryAfter, err := limiter.Allow(context.Background(), "a", config.Limit{Rate: 0, Burst: 2})
+
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Zero(t, retryAfter)
+}
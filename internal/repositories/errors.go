@@ -3,7 +3,9 @@ package repositories
 import "errors"
 
 var (
-	ErrRecordNotFound = errors.New("record not found")
-	ErrAlreadyExists  = errors.New("the record exists")
-	ErrMaxRegistered  = errors.New("the maximum number of users has been registered")
+	ErrRecordNotFound  = errors.New("record not found")
+	ErrAlreadyExists   = errors.New("the record exists")
+	ErrMaxRegistered   = errors.New("the maximum number of users has been registered")
+	ErrVersionMismatch = errors.New("the record version does not match")
+	ErrSoldOut         = errors.New("the ticket type is sold out")
 )
@@ -4,11 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/Estriper0/EventService/internal/logger"
 	"github.com/Estriper0/EventService/internal/models"
 	"github.com/Estriper0/EventService/internal/repositories"
+	eventhistory "github.com/Estriper0/EventService/internal/repositories/event_history"
+	"github.com/Estriper0/EventService/internal/tracing"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/repositories/event")
+
 type EventRepository struct {
 	db *sql.DB
 }
@@ -19,13 +28,16 @@ func New(db *sql.DB) *EventRepository {
 	}
 }
 
-func (r *EventRepository) GetById(
-	ctx context.Context,
-	id int,
-) (*models.EventResponse, error) {
-	query := "SELECT * FROM event.events WHERE id = $1"
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanEvent reads a row selected with SELECT * from event.events.
+func scanEvent(row scanner, extra ...any) (*models.EventResponse, error) {
 	event := &models.EventResponse{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	var cutoff sql.NullInt64
+	dest := []any{
 		&event.Id,
 		&event.Title,
 		&event.About,
@@ -35,23 +47,101 @@ func (r *EventRepository) GetById(
 		&event.MaxAttendees,
 		&event.CurrentAttendance,
 		&event.Creator,
-	)
+		&event.Version,
+		&event.DeletedAt,
+		&event.RequiresApproval,
+		&event.RegistrationOpensAt,
+		&event.RegistrationClosesAt,
+		&cutoff,
+		&event.MaxGuests,
+		&event.AllowTransfers,
+		&event.Visibility,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	if cutoff.Valid {
+		d := time.Duration(cutoff.Int64) * time.Second
+		event.CancellationCutoff = &d
+	}
+	return event, nil
+}
+
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// write runs fn on the database. With a history entry it runs fn in a transaction
+// and records the entry, completed by fn, in it, so that an event never changes
+// without its history.
+func (r *EventRepository) write(ctx context.Context, history *models.EventHistory, fn func(q querier) error) error {
+	if history == nil {
+		return fn(r.db)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := eventhistory.Insert(ctx, tx, history); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// revise sets the event id and the new version of the event on the history entry.
+func revise(history *models.EventHistory, id int, version int) {
+	if history == nil {
+		return
+	}
+	history.EventId = id
+	history.Revision = version
+	history.Snapshot.Id = id
+	history.Snapshot.Version = version
+}
+
+func (r *EventRepository) GetById(
+	ctx context.Context,
+	id int,
+) (*models.EventResponse, error) {
+	query := "SELECT * FROM event.events WHERE id = $1 AND deleted_at IS NULL"
+	ctx, span := tracer.Start(ctx, "EventRepository.GetById", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	event, err := scanEvent(r.db.QueryRowContext(ctx, query, id))
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repositories.ErrRecordNotFound
 		}
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	return event, nil
 }
 
+// GetAll returns the events visible to the viewer.
 func (r *EventRepository) GetAll(
 	ctx context.Context,
+	viewer *models.Viewer,
 ) ([]*models.EventResponse, error) {
-	query := "SELECT * FROM event.events ORDER BY title"
-	rows, err := r.db.QueryContext(ctx, query)
+	condition, args := visible(viewer, 1)
+	query := "SELECT * FROM event.events WHERE deleted_at IS NULL" + condition + " ORDER BY title"
+	ctx, span := tracer.Start(ctx, "EventRepository.GetAll", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -59,107 +149,363 @@ func (r *EventRepository) GetAll(
 	res := []*models.EventResponse{}
 
 	for rows.Next() {
-		event := &models.EventResponse{}
-		err := rows.Scan(
-			&event.Id,
-			&event.Title,
-			&event.About,
-			&event.StartDate,
-			&event.Location,
-			&event.Status,
-			&event.MaxAttendees,
-			&event.CurrentAttendance,
-			&event.Creator,
-		)
+		event, err := scanEvent(rows)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return nil, err
 		}
 		res = append(res, event)
 	}
 
 	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	return res, nil
 }
 
+// Create stores the event and makes its creator the owner among its organizers.
+// The history entry, when not nil, is completed with the event id and written in
+// the same transaction.
 func (r *EventRepository) Create(
 	ctx context.Context,
 	event *models.EventCreateRequest,
+	history *models.EventHistory,
 ) (int, error) {
-	var id int
-	query := "INSERT INTO event.events (title, about, start_date, location, status, max_attendees, creator) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id"
-	err := r.db.QueryRowContext(
-		ctx,
-		query,
-		event.Title,
-		event.About,
-		event.StartDate,
-		event.Location,
-		event.Status,
-		event.MaxAttendees,
-		event.Creator,
-	).Scan(&id)
+	var id, version int
+	query := "WITH created AS (INSERT INTO event.events (title, about, start_date, location, status, max_attendees, creator, requires_approval, " +
+		"registration_opens_at, registration_closes_at, cancellation_cutoff, max_guests, allow_transfers, visibility) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id, creator, version), " +
+		"owner AS (INSERT INTO event.event_organizers (event_id, user_id, role) SELECT id, creator, 'owner' FROM created) " +
+		"SELECT id, version FROM created"
+	ctx, span := tracer.Start(ctx, "EventRepository.Create", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	visibility := event.Visibility
+	if visibility == "" {
+		visibility = models.VisibilityPublic
+	}
+	err := r.write(ctx, history, func(q querier) error {
+		err := q.QueryRowContext(
+			ctx,
+			query,
+			event.Title,
+			event.About,
+			event.StartDate,
+			event.Location,
+			event.Status,
+			event.MaxAttendees,
+			event.Creator,
+			event.RequiresApproval,
+			event.RegistrationOpensAt,
+			event.RegistrationClosesAt,
+			seconds(event.CancellationCutoff),
+			event.MaxGuests,
+			event.AllowTransfers,
+			visibility,
+		).Scan(&id, &version)
+		if err != nil {
+			return err
+		}
+		revise(history, id, version)
+		return nil
+	})
 
 	if err != nil {
+		tracing.RecordError(span, err)
 		return 0, err
 	}
 	return id, nil
 }
 
+// DeleteById marks the event as deleted, the row is removed later by Purge.
+// A non-zero version makes the deletion conditional on it. The history entry, when
+// not nil, is completed with the new version and written in the same transaction.
 func (r *EventRepository) DeleteById(
 	ctx context.Context,
 	id int,
+	version int,
+	history *models.EventHistory,
 ) error {
-	query := "DELETE FROM event.events WHERE id = $1"
-	res, err := r.db.ExecContext(ctx, query, id)
+	query := "UPDATE event.events SET deleted_at = NOW(), version = version + 1 WHERE id = $1 AND deleted_at IS NULL AND ($2 = 0 OR version = $2) RETURNING version, deleted_at"
+	ctx, span := tracer.Start(ctx, "EventRepository.DeleteById", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	err := r.write(ctx, history, func(q querier) error {
+		var deleted int
+		var deletedAt time.Time
+		err := q.QueryRowContext(ctx, query, id, version).Scan(&deleted, &deletedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			if version != 0 {
+				return repositories.ErrVersionMismatch
+			}
+			return repositories.ErrRecordNotFound
+		}
+		if err != nil {
+			return err
+		}
+		revise(history, id, deleted)
+		if history != nil {
+			history.Snapshot.DeletedAt = &deletedAt
+		}
+		return nil
+	})
 	if err != nil {
+		if !errors.Is(err, repositories.ErrVersionMismatch) && !errors.Is(err, repositories.ErrRecordNotFound) {
+			tracing.RecordError(span, err)
+		}
 		return err
 	}
+	return nil
+}
 
-	i, _ := res.RowsAffected()
-	if i == 0 {
-		return repositories.ErrRecordNotFound
+// GetDeletedById returns an event that has been deleted but not purged yet.
+func (r *EventRepository) GetDeletedById(
+	ctx context.Context,
+	id int,
+) (*models.EventResponse, error) {
+	query := "SELECT * FROM event.events WHERE id = $1 AND deleted_at IS NOT NULL"
+	ctx, span := tracer.Start(ctx, "EventRepository.GetDeletedById", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	event, err := scanEvent(r.db.QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return nil, err
 	}
-	return nil
+	return event, nil
 }
 
-func (r *EventRepository) Update(
+// Restore clears the deletion mark of the event and returns its new version. The
+// history entry, when not nil, is completed with it and written in the same transaction.
+func (r *EventRepository) Restore(
 	ctx context.Context,
-	event *models.EventUpdateRequest,
-) error {
-	query := "UPDATE event.events SET title = $1, about = $2, start_date = $3, location = $4, status = $5, max_attendees = $6 WHERE id = $7"
-	res, err := r.db.ExecContext(
-		ctx,
-		query,
-		event.Title,
-		event.About,
-		event.StartDate,
-		event.Location,
-		event.Status,
-		event.MaxAttendees,
-		event.Id,
-	)
+	id int,
+	history *models.EventHistory,
+) (int, error) {
+	query := "UPDATE event.events SET deleted_at = NULL, version = version + 1 WHERE id = $1 AND deleted_at IS NOT NULL RETURNING version"
+	ctx, span := tracer.Start(ctx, "EventRepository.Restore", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	var version int
+	err := r.write(ctx, history, func(q querier) error {
+		err := q.QueryRowContext(ctx, query, id).Scan(&version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repositories.ErrRecordNotFound
+		}
+		if err != nil {
+			return err
+		}
+		revise(history, id, version)
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, repositories.ErrRecordNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return 0, err
+	}
+	return version, nil
+}
 
+// Purge permanently removes the events deleted before the given time together
+// with their registrations and, in the same statement, their history, which has
+// no foreign key to cascade from. It returns the number of removed events.
+func (r *EventRepository) Purge(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	query := "WITH purged AS (DELETE FROM event.events WHERE deleted_at < $1 RETURNING id), " +
+		"history AS (DELETE FROM event.event_history WHERE event_id IN (SELECT id FROM purged)) " +
+		"SELECT COUNT(*) FROM purged"
+	ctx, span := tracer.Start(ctx, "EventRepository.Purge", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	var i int64
+	err := r.db.QueryRowContext(ctx, query, before.UTC()).Scan(&i)
 	if err != nil {
-		return repositories.ErrRecordNotFound
+		tracing.RecordError(span, err)
+		return 0, err
 	}
+	return i, nil
+}
 
-	i, _ := res.RowsAffected()
-	if i == 0 {
-		return repositories.ErrRecordNotFound
+// updateColumns lists the columns written by Update in a stable order.
+// A column whose value is nil is left unchanged.
+var updateColumns = []struct {
+	name  string
+	value func(*models.EventUpdateRequest) any
+}{
+	{models.FieldTitle, func(e *models.EventUpdateRequest) any { return e.Title }},
+	{models.FieldAbout, func(e *models.EventUpdateRequest) any { return e.About }},
+	{models.FieldStartDate, func(e *models.EventUpdateRequest) any { return e.StartDate }},
+	{models.FieldLocation, func(e *models.EventUpdateRequest) any { return e.Location }},
+	{models.FieldStatus, func(e *models.EventUpdateRequest) any { return e.Status }},
+	{models.FieldMaxAttendees, func(e *models.EventUpdateRequest) any { return e.MaxAttendees }},
+	{models.FieldRequiresApproval, func(e *models.EventUpdateRequest) any {
+		if e.RequiresApproval == nil {
+			return nil
+		}
+		return *e.RequiresApproval
+	}},
+	{models.FieldRegistrationOpens, func(e *models.EventUpdateRequest) any {
+		if e.RegistrationOpensAt == nil {
+			return nil
+		}
+		return *e.RegistrationOpensAt
+	}},
+	{models.FieldRegistrationCloses, func(e *models.EventUpdateRequest) any {
+		if e.RegistrationClosesAt == nil {
+			return nil
+		}
+		return *e.RegistrationClosesAt
+	}},
+	{models.FieldCancellationCutoff, func(e *models.EventUpdateRequest) any {
+		if e.CancellationCutoff == nil {
+			return nil
+		}
+		return seconds(e.CancellationCutoff)
+	}},
+	{models.FieldMaxGuests, func(e *models.EventUpdateRequest) any {
+		if e.MaxGuests == nil {
+			return nil
+		}
+		return *e.MaxGuests
+	}},
+	{models.FieldAllowTransfers, func(e *models.EventUpdateRequest) any {
+		if e.AllowTransfers == nil {
+			return nil
+		}
+		return *e.AllowTransfers
+	}},
+	{models.FieldVisibility, func(e *models.EventUpdateRequest) any {
+		if e.Visibility == nil {
+			return nil
+		}
+		return *e.Visibility
+	}},
+}
+
+// seconds converts an optional duration to the whole seconds stored in the table.
+func seconds(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
 	}
+	s := int64(d.Seconds())
+	return &s
+}
 
-	return nil
+// visible returns the condition on event.events that lists only the events visible
+// to the viewer, whose id is the query parameter n. Public events are listed to
+// everyone, the others only to their creator and organizers, to users with a personal
+// invite that is not revoked and to users with an active or pending registration; a
+// cancelled or rejected one does not reveal the event. A nil viewer sees all events.
+func visible(viewer *models.Viewer, n int) (string, []any) {
+	if viewer == nil {
+		return "", nil
+	}
+	condition := fmt.Sprintf(" AND (events.visibility = 'public' OR events.creator::text = lower($%[1]d) "+
+		"OR EXISTS (SELECT 1 FROM event.event_organizers WHERE event_organizers.event_id = events.id AND event_organizers.user_id::text = lower($%[1]d)) "+
+		"OR EXISTS (SELECT 1 FROM event.event_invites WHERE event_invites.event_id = events.id AND event_invites.user_id::text = lower($%[1]d) AND event_invites.revoked_at IS NULL) "+
+		"OR EXISTS (SELECT 1 FROM event.event_user WHERE event_user.event_id = events.id AND event_user.user_id::text = lower($%[1]d) "+
+		"AND event_user.status IN ('active', 'pending')))", n)
+	return condition, []any{viewer.UserId}
 }
 
+// Update writes only the fields selected by event.Fields, or all of them when it is empty,
+// and bumps the version. A non-zero event.Version makes the update conditional on it.
+// It returns the new version. The history entry, when not nil, is completed with it and
+// written in the same transaction, an update that changes nothing writes no entry.
+func (r *EventRepository) Update(
+	ctx context.Context,
+	event *models.EventUpdateRequest,
+	history *models.EventHistory,
+) (int, error) {
+	sets := make([]string, 0, len(updateColumns))
+	args := make([]any, 0, len(updateColumns)+1)
+	for _, column := range updateColumns {
+		if !event.Has(column.name) {
+			continue
+		}
+		value := column.value(event)
+		if value == nil {
+			continue
+		}
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column.name, len(args)))
+	}
+	if len(sets) == 0 {
+		return event.Version, nil
+	}
+	sets = append(sets, "version = version + 1")
+	args = append(args, event.Id)
+	where := fmt.Sprintf("id = $%d AND deleted_at IS NULL", len(args))
+	if event.Version != 0 {
+		args = append(args, event.Version)
+		where += fmt.Sprintf(" AND version = $%d", len(args))
+	}
+
+	query := fmt.Sprintf("UPDATE event.events SET %s WHERE %s RETURNING version", strings.Join(sets, ", "), where)
+	ctx, span := tracer.Start(ctx, "EventRepository.Update", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	var version int
+	err := r.write(ctx, history, func(q querier) error {
+		err := q.QueryRowContext(ctx, query, args...).Scan(&version)
+		if errors.Is(err, sql.ErrNoRows) {
+			if event.Version != 0 {
+				return repositories.ErrVersionMismatch
+			}
+			return repositories.ErrRecordNotFound
+		}
+		if err != nil {
+			return err
+		}
+		revise(history, event.Id, version)
+		return nil
+	})
+
+	if err != nil {
+		if !errors.Is(err, repositories.ErrVersionMismatch) && !errors.Is(err, repositories.ErrRecordNotFound) {
+			tracing.RecordError(span, err)
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// GetAllByCreator returns the events of the creator visible to the viewer. With
+// organized it also returns the events the creator co-organizes in any role.
 func (r *EventRepository) GetAllByCreator(
 	ctx context.Context,
 	creator string,
+	organized bool,
+	viewer *models.Viewer,
 ) ([]*models.EventResponse, error) {
-	query := "SELECT * FROM event.events WHERE creator = $1 ORDER BY title"
-	rows, err := r.db.QueryContext(ctx, query, creator)
+	condition, args := visible(viewer, 2)
+	owner := "creator = $1"
+	if organized {
+		owner = "(creator = $1 OR EXISTS (SELECT 1 FROM event.event_organizers WHERE event_organizers.event_id = events.id AND event_organizers.user_id = $1))"
+	}
+	query := "SELECT * FROM event.events WHERE " + owner + " AND deleted_at IS NULL" + condition + " ORDER BY title"
+	ctx, span := tracer.Start(ctx, "EventRepository.GetAllByCreator", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, append([]any{creator}, args...)...)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -167,37 +513,36 @@ func (r *EventRepository) GetAllByCreator(
 	res := []*models.EventResponse{}
 
 	for rows.Next() {
-		event := &models.EventResponse{}
-		err := rows.Scan(
-			&event.Id,
-			&event.Title,
-			&event.About,
-			&event.StartDate,
-			&event.Location,
-			&event.Status,
-			&event.MaxAttendees,
-			&event.CurrentAttendance,
-			&event.Creator,
-		)
+		event, err := scanEvent(rows)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return nil, err
 		}
 		res = append(res, event)
 	}
 
 	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	return res, nil
 }
 
+// GetAllByStatus returns the events with the status visible to the viewer.
 func (r *EventRepository) GetAllByStatus(
 	ctx context.Context,
 	status string,
+	viewer *models.Viewer,
 ) ([]*models.EventResponse, error) {
-	query := "SELECT * FROM event.events WHERE status = $1 ORDER BY title"
-	rows, err := r.db.QueryContext(ctx, query, status)
+	condition, args := visible(viewer, 2)
+	query := "SELECT * FROM event.events WHERE status = $1 AND deleted_at IS NULL" + condition + " ORDER BY title"
+	ctx, span := tracer.Start(ctx, "EventRepository.GetAllByStatus", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, append([]any{status}, args...)...)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	defer rows.Close()
@@ -205,35 +550,33 @@ func (r *EventRepository) GetAllByStatus(
 	res := []*models.EventResponse{}
 
 	for rows.Next() {
-		event := &models.EventResponse{}
-		err := rows.Scan(
-			&event.Id,
-			&event.Title,
-			&event.About,
-			&event.StartDate,
-			&event.Location,
-			&event.Status,
-			&event.MaxAttendees,
-			&event.CurrentAttendance,
-			&event.Creator,
-		)
+		event, err := scanEvent(rows)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return nil, err
 		}
 		res = append(res, event)
 	}
 
 	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	return res, nil
 }
 
-func (r *EventRepository) IncreaseCurrentAttedance(ctx context.Context, event_id int) error {
-	query := "UPDATE event.events SET current_attendance = current_attendance + 1 WHERE id = $1"
-	res, err := r.db.ExecContext(ctx, query, event_id)
+// IncreaseCurrentAttedance takes the seats of the event, failing with ErrMaxRegistered
+// when there are not enough of them.
+func (r *EventRepository) IncreaseCurrentAttedance(ctx context.Context, event_id int, seats int) error {
+	query := "UPDATE event.events SET current_attendance = current_attendance + $2, version = version + 1 WHERE id = $1 AND deleted_at IS NULL"
+	ctx, span := tracer.Start(ctx, "EventRepository.IncreaseCurrentAttedance", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	res, err := r.db.ExecContext(ctx, query, event_id, seats)
 
 	if err != nil {
+		tracing.RecordError(span, err)
 		return repositories.ErrMaxRegistered
 	}
 
@@ -245,11 +588,16 @@ func (r *EventRepository) IncreaseCurrentAttedance(ctx context.Context, event_id
 	return nil
 }
 
-func (r *EventRepository) DecreaseCurrentAttedance(ctx context.Context, event_id int) error {
-	query := "UPDATE event.events SET current_attendance = current_attendance - 1 WHERE id = $1"
-	res, err := r.db.ExecContext(ctx, query, event_id)
+func (r *EventRepository) DecreaseCurrentAttedance(ctx context.Context, event_id int, seats int) error {
+	query := "UPDATE event.events SET current_attendance = current_attendance - $2, version = version + 1 WHERE id = $1 AND deleted_at IS NULL"
+	ctx, span := tracer.Start(ctx, "EventRepository.DecreaseCurrentAttedance", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	res, err := r.db.ExecContext(ctx, query, event_id, seats)
 
 	if err != nil {
+		tracing.RecordError(span, err)
 		return err
 	}
 
@@ -261,36 +609,49 @@ func (r *EventRepository) DecreaseCurrentAttedance(ctx context.Context, event_id
 	return nil
 }
 
-func (r *EventRepository) GetAllByUser(ctx context.Context, user_id string) ([]*models.EventResponse, error) {
-	query := "SELECT event.events.* FROM event.events JOIN event.event_user ON events.id = event_user.event_id WHERE event_user.user_id = $1"
-	rows, err := r.db.QueryContext(ctx, query, user_id)
+// GetAllByUser returns the events the user has registered for that are visible to
+// the viewer, including cancelled registrations, together with the registration metadata.
+func (r *EventRepository) GetAllByUser(ctx context.Context, user_id string, viewer *models.Viewer) ([]*models.EventRegistration, error) {
+	condition, args := visible(viewer, 2)
+	query := "SELECT event.events.*, event_user.status, event_user.registered_at, event_user.cancelled_at, event_user.cancellations, " +
+		"COALESCE(event_user.ticket_type_id, 0), event_user.checked_in_at, event_user.guests " +
+		"FROM event.events JOIN event.event_user ON events.id = event_user.event_id " +
+		"WHERE event_user.user_id = $1 AND events.deleted_at IS NULL" + condition + " ORDER BY event_user.registered_at"
+	ctx, span := tracer.Start(ctx, "EventRepository.GetAllByUser", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, append([]any{user_id}, args...)...)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	defer rows.Close()
 
-	res := []*models.EventResponse{}
+	res := []*models.EventRegistration{}
 
 	for rows.Next() {
-		event := &models.EventResponse{}
-		err := rows.Scan(
-			&event.Id,
-			&event.Title,
-			&event.About,
-			&event.StartDate,
-			&event.Location,
-			&event.Status,
-			&event.MaxAttendees,
-			&event.CurrentAttendance,
-			&event.Creator,
+		registration := &models.Registration{UserId: user_id}
+		event, err := scanEvent(
+			rows,
+			&registration.Status,
+			&registration.RegisteredAt,
+			&registration.CancelledAt,
+			&registration.Cancellations,
+			&registration.TicketTypeId,
+			&registration.CheckedInAt,
+			&registration.Guests,
 		)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return nil, err
 		}
-		res = append(res, event)
+		registration.EventId = event.Id
+		res = append(res, &models.EventRegistration{Event: event, Registration: registration})
 	}
 
 	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	return res, nil
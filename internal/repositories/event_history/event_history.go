@@ -0,0 +1,183 @@
+package eventhistory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/repositories/event_history")
+
+type EventHistoryRepository struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *EventHistoryRepository {
+	return &EventHistoryRepository{
+		db: db,
+	}
+}
+
+// snapshot is the JSON form of models.EventResponse stored in event_history.snapshot.
+type snapshot struct {
+	Id                int        `json:"id"`
+	Title             string     `json:"title"`
+	About             string     `json:"about"`
+	StartDate         time.Time  `json:"start_date"`
+	Location          string     `json:"location"`
+	Status            string     `json:"status"`
+	MaxAttendees      int        `json:"max_attendees"`
+	CurrentAttendance int        `json:"current_attendance"`
+	Creator           string     `json:"creator"`
+	Version           int        `json:"version"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+	RequiresApproval  bool       `json:"requires_approval"`
+
+	RegistrationOpensAt  *time.Time     `json:"registration_opens_at,omitempty"`
+	RegistrationClosesAt *time.Time     `json:"registration_closes_at,omitempty"`
+	CancellationCutoff   *time.Duration `json:"cancellation_cutoff,omitempty"`
+	MaxGuests            int            `json:"max_guests"`
+	AllowTransfers       bool           `json:"allow_transfers"`
+	Visibility           string         `json:"visibility,omitempty"`
+}
+
+const insertQuery = "INSERT INTO event.event_history (event_id, revision, action, actor, changes, snapshot) VALUES ($1, $2, $3, $4, $5, $6)"
+
+// Execer is implemented by *sql.DB and *sql.Tx.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func (r *EventHistoryRepository) Create(ctx context.Context, history *models.EventHistory) error {
+	ctx, span := tracer.Start(ctx, "EventHistoryRepository.Create", tracing.DB(insertQuery))
+	defer span.End()
+
+	if err := Insert(ctx, r.db, history); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// Insert writes the history entry with exec. The repositories that change an event
+// pass their transaction, so that the change and its entry are committed together.
+func Insert(ctx context.Context, exec Execer, history *models.EventHistory) error {
+	logger.Query(ctx, insertQuery)
+
+	changes := history.Changes
+	if changes == nil {
+		changes = map[string]models.FieldChange{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	snapshotJSON, err := json.Marshal(snapshot(*history.Snapshot))
+	if err != nil {
+		return err
+	}
+
+	_, err = exec.ExecContext(
+		ctx,
+		insertQuery,
+		history.EventId,
+		history.Revision,
+		history.Action,
+		history.Actor,
+		changesJSON,
+		snapshotJSON,
+	)
+	return err
+}
+
+func (r *EventHistoryRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.EventHistory, error) {
+	query := "SELECT id, event_id, revision, action, actor, changed_at, changes, snapshot FROM event.event_history WHERE event_id = $1 ORDER BY revision, id"
+	ctx, span := tracer.Start(ctx, "EventHistoryRepository.GetAllByEvent", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, event_id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*models.EventHistory{}
+
+	for rows.Next() {
+		history, err := scanHistory(rows)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		res = append(res, history)
+	}
+
+	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetRevision returns the latest history entry of the event at or before the revision.
+// Revisions are event versions, so writes that are not recorded, like registrations,
+// leave gaps that resolve to the preceding entry.
+func (r *EventHistoryRepository) GetRevision(ctx context.Context, event_id int, revision int) (*models.EventHistory, error) {
+	query := "SELECT id, event_id, revision, action, actor, changed_at, changes, snapshot FROM event.event_history WHERE event_id = $1 AND revision <= $2 ORDER BY revision DESC, id DESC LIMIT 1"
+	ctx, span := tracer.Start(ctx, "EventHistoryRepository.GetRevision", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	history, err := scanHistory(r.db.QueryRowContext(ctx, query, event_id, revision))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return history, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanHistory(row scanner) (*models.EventHistory, error) {
+	history := &models.EventHistory{}
+	var changesJSON, snapshotJSON []byte
+	err := row.Scan(
+		&history.Id,
+		&history.EventId,
+		&history.Revision,
+		&history.Action,
+		&history.Actor,
+		&history.ChangedAt,
+		&changesJSON,
+		&snapshotJSON,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(changesJSON, &history.Changes); err != nil {
+		return nil, err
+	}
+	var s snapshot
+	if err := json.Unmarshal(snapshotJSON, &s); err != nil {
+		return nil, err
+	}
+	event := models.EventResponse(s)
+	history.Snapshot = &event
+	return history, nil
+}
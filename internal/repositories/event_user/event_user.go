@@ -3,11 +3,21 @@ package eventuser
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"time"
 
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/models"
 	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/tracing"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/repositories/event_user")
+
 type EventUserRepository struct {
 	db *sql.DB
 }
@@ -19,7 +29,11 @@ func New(db *sql.DB) *EventUserRepository {
 }
 
 func (r *EventUserRepository) Exists(ctx context.Context, user_id string, event_id int) (bool, error) {
-	query := "SELECT user_id, event_id FROM event.event_user WHERE user_id = $1 AND event_id = $2"
+	query := "SELECT user_id, event_id FROM event.event_user WHERE user_id = $1 AND event_id = $2 AND status = 'active'"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Exists", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
 	var ui string
 	var ei int
 	err := r.db.QueryRowContext(ctx, query, user_id, event_id).Scan(&ui, &ei)
@@ -27,24 +41,371 @@ func (r *EventUserRepository) Exists(ctx context.Context, user_id string, event_
 		if errors.Is(err, sql.ErrNoRows) {
 			return false, nil
 		}
+		tracing.RecordError(span, err)
 		return false, err
 	}
 	return true, nil
 }
 
-func (r *EventUserRepository) Create(ctx context.Context, user_id string, event_id int) error {
-	query := "INSERT INTO event.event_user (user_id, event_id) VALUES ($1, $2)"
-	_, err := r.db.ExecContext(ctx, query, user_id, event_id)
+// Get returns the registration of the user for the event in any status.
+func (r *EventUserRepository) Get(ctx context.Context, user_id string, event_id int) (*models.Registration, error) {
+	query := "SELECT event_id, user_id, status, registered_at, cancelled_at, cancellations, COALESCE(ticket_type_id, 0), checked_in_at, guests, answers " +
+		"FROM event.event_user WHERE user_id = $1 AND event_id = $2"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Get", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	registration, err := scanRegistration(r.db.QueryRowContext(ctx, query, user_id, event_id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return registration, nil
+}
+
+const createQuery = "INSERT INTO event.event_user (user_id, event_id, status, ticket_type_id, guests, answers) VALUES ($1, $2, $3, NULLIF($4, 0), $5, $6) " +
+	"ON CONFLICT (event_id, user_id) DO UPDATE SET status = EXCLUDED.status, ticket_type_id = EXCLUDED.ticket_type_id, guests = EXCLUDED.guests, " +
+	"answers = EXCLUDED.answers, " +
+	"registered_at = NOW(), cancelled_at = NULL, checked_in_at = NULL " +
+	"WHERE event_user.status = 'cancelled'"
+
+// Create registers the user for the event with the status, active or pending, the ticket
+// type, the guests and the answers of the registration, reactivating a cancelled registration.
+func (r *EventUserRepository) Create(ctx context.Context, registration *models.Registration) error {
+	query := createQuery
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Create", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	answers, err := marshalAnswers(registration.Answers)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, query, registration.UserId, registration.EventId, registration.Status, registration.TicketTypeId, registration.Guests, answers)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return repositories.ErrAlreadyExists
+	}
+	i, _ := res.RowsAffected()
+	if i == 0 {
+		return repositories.ErrAlreadyExists
+	}
+	return nil
+}
+
+// Register creates the registration like Create and, when it is active, takes the seats
+// of the user and the guests of the event and of its ticket type in the same transaction,
+// so a registration is never stored without its seats or the other way round. It fails
+// with ErrSoldOut when the ticket type is full, ErrMaxRegistered when the event is full
+// and ErrRecordNotFound when the event is deleted.
+func (r *EventUserRepository) Register(ctx context.Context, registration *models.Registration) error {
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Register", tracing.DB(createQuery+"; "+reserveQuery+"; "+attendQuery))
+	defer span.End()
+
+	answers, err := marshalAnswers(registration.Answers)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	defer tx.Rollback()
+
+	logger.Query(ctx, createQuery)
+	res, err := tx.ExecContext(ctx, createQuery, registration.UserId, registration.EventId, registration.Status, registration.TicketTypeId, registration.Guests, answers)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return repositories.ErrAlreadyExists
+	}
+	i, _ := res.RowsAffected()
+	if i == 0 {
+		return repositories.ErrAlreadyExists
+	}
+
+	if registration.Status == models.RegistrationActive {
+		if err := takeSeats(ctx, tx, registration.EventId, registration.TicketTypeId, registration.Seats()); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// CreateGroup registers the users for the event like Create, all of them with the
+// status and the ticket type of the registration, and takes their seats in the same
+// transaction. Users with a registration that is not cancelled are skipped. Either all
+// the other users are registered or none of them, failing like takeSeats when there
+// are not enough seats. It returns the registered users.
+func (r *EventUserRepository) CreateGroup(ctx context.Context, registration *models.Registration, user_ids []string) ([]string, error) {
+	query := "INSERT INTO event.event_user (user_id, event_id, status, ticket_type_id) " +
+		"SELECT user_id, $1::integer, $2::registration_status, NULLIF($3::integer, 0) FROM unnest($4::uuid[]) AS user_id " +
+		"WHERE EXISTS (SELECT 1 FROM event.events WHERE id = $1 AND deleted_at IS NULL) " +
+		"ON CONFLICT (event_id, user_id) DO UPDATE SET status = EXCLUDED.status, ticket_type_id = EXCLUDED.ticket_type_id, guests = EXCLUDED.guests, " +
+		"answers = EXCLUDED.answers, registered_at = NOW(), cancelled_at = NULL, checked_in_at = NULL " +
+		"WHERE event_user.status = 'cancelled' RETURNING user_id"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.CreateGroup", tracing.DB(query+"; "+reserveQuery+"; "+attendQuery))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, registration.EventId, registration.Status, registration.TicketTypeId, pq.Array(user_ids))
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	registered := []string{}
+
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		registered = append(registered, user_id)
+	}
+
+	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	rows.Close()
+
+	if registration.Status == models.RegistrationActive && len(registered) > 0 {
+		if err := takeSeats(ctx, tx, registration.EventId, registration.TicketTypeId, len(registered)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return registered, nil
+}
+
+// Cancel marks an active or pending registration as cancelled, the row is kept. The seats
+// of an active registration are given back to the event and to its ticket type in the
+// same transaction, keyed on the cancelled row, so they are released exactly once.
+func (r *EventUserRepository) Cancel(ctx context.Context, user_id string, event_id int) error {
+	query := "UPDATE event.event_user SET status = 'cancelled', cancelled_at = NOW(), cancellations = cancellations + 1 " +
+		"FROM (SELECT user_id, event_id, status FROM event.event_user WHERE user_id = $1 AND event_id = $2 AND status IN ('active', 'pending') FOR UPDATE) AS registration " +
+		"WHERE event_user.user_id = registration.user_id AND event_user.event_id = registration.event_id " +
+		"RETURNING registration.status, COALESCE(event_user.ticket_type_id, 0), 1 + event_user.guests"
+	release := "UPDATE event.ticket_types SET sold = sold - $3 WHERE id = $1 AND event_id = $2"
+	attend := "UPDATE event.events SET current_attendance = current_attendance - $2, version = version + 1 WHERE id = $1 AND deleted_at IS NULL"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Cancel", tracing.DB(query+"; "+release+"; "+attend))
+	defer span.End()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	defer tx.Rollback()
+
+	logger.Query(ctx, query)
+	var status string
+	var ticketTypeId, seats int
+	err = tx.QueryRowContext(ctx, query, user_id, event_id).Scan(&status, &ticketTypeId, &seats)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	if status == models.RegistrationActive {
+		if ticketTypeId != 0 {
+			logger.Query(ctx, release)
+			if _, err := tx.ExecContext(ctx, release, ticketTypeId, event_id, seats); err != nil {
+				tracing.RecordError(span, err)
+				return err
+			}
+		}
+
+		logger.Query(ctx, attend)
+		res, err := tx.ExecContext(ctx, attend, event_id, seats)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		i, _ := res.RowsAffected()
+		if i == 0 {
+			return repositories.ErrRecordNotFound
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// Approve activates a pending registration and takes the seats of the user and the guests
+// of the event and of its ticket type in the same transaction, so the registration stays
+// pending when either is full. It fails like takeSeats when there are not enough seats.
+func (r *EventUserRepository) Approve(ctx context.Context, user_id string, event_id int) error {
+	query := "UPDATE event.event_user SET status = 'active' WHERE user_id = $1 AND event_id = $2 AND status = 'pending' " +
+		"RETURNING COALESCE(ticket_type_id, 0), 1 + guests"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Approve", tracing.DB(query+"; "+reserveQuery+"; "+attendQuery))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	defer tx.Rollback()
+
+	var ticketTypeId, seats int
+	err = tx.QueryRowContext(ctx, query, user_id, event_id).Scan(&ticketTypeId, &seats)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	if err := takeSeats(ctx, tx, event_id, ticketTypeId, seats); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// Transfer moves the active registration of from to the user to in one transaction.
+// The registration of from is cancelled and to gets an active registration with the
+// same ticket type and guests, so the seats of the event stay taken. A cancelled
+// registration of to is reactivated, any other one fails with ErrAlreadyExists.
+// A checked in registration cannot be transferred.
+func (r *EventUserRepository) Transfer(ctx context.Context, event_id int, from string, to string) error {
+	release := "UPDATE event.event_user SET status = 'cancelled', cancelled_at = NOW() " +
+		"WHERE user_id = $1 AND event_id = $2 AND status = 'active' AND checked_in_at IS NULL " +
+		"RETURNING COALESCE(ticket_type_id, 0), guests"
+	take := "INSERT INTO event.event_user (user_id, event_id, status, ticket_type_id, guests) VALUES ($1, $2, 'active', NULLIF($3, 0), $4) " +
+		"ON CONFLICT (event_id, user_id) DO UPDATE SET status = EXCLUDED.status, ticket_type_id = EXCLUDED.ticket_type_id, guests = EXCLUDED.guests, " +
+		"answers = EXCLUDED.answers, registered_at = NOW(), cancelled_at = NULL, checked_in_at = NULL " +
+		"WHERE event_user.status = 'cancelled'"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Transfer", tracing.DB(release+"; "+take))
+	defer span.End()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	defer tx.Rollback()
+
+	logger.Query(ctx, release)
+	var ticketTypeId, guests int
+	err = tx.QueryRowContext(ctx, release, from, event_id).Scan(&ticketTypeId, &guests)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	logger.Query(ctx, take)
+	res, err := tx.ExecContext(ctx, take, to, event_id, ticketTypeId, guests)
 	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	i, _ := res.RowsAffected()
+	if i == 0 {
 		return repositories.ErrAlreadyExists
 	}
+
+	if err := tx.Commit(); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
 	return nil
 }
 
-func (r *EventUserRepository) Delete(ctx context.Context, user_id string, event_id int) error {
-	query := "DELETE FROM event.event_user WHERE user_id = $1 AND event_id = $2"
+// SetGuests changes the guests of an active or pending registration. For an active one
+// the difference in seats is taken from or given back to the event and the ticket type
+// in the same transaction, failing like takeSeats when there are not enough seats.
+func (r *EventUserRepository) SetGuests(ctx context.Context, user_id string, event_id int, guests int) error {
+	query := "UPDATE event.event_user SET guests = $3 " +
+		"FROM (SELECT user_id, event_id, guests FROM event.event_user WHERE user_id = $1 AND event_id = $2 AND status IN ('active', 'pending') FOR UPDATE) AS registration " +
+		"WHERE event_user.user_id = registration.user_id AND event_user.event_id = registration.event_id " +
+		"RETURNING event_user.status, COALESCE(event_user.ticket_type_id, 0), $3 - registration.guests"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.SetGuests", tracing.DB(query+"; "+reserveQuery+"; "+attendQuery))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	defer tx.Rollback()
+
+	var status string
+	var ticketTypeId, seats int
+	err = tx.QueryRowContext(ctx, query, user_id, event_id, guests).Scan(&status, &ticketTypeId, &seats)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	if status == models.RegistrationActive && seats != 0 {
+		if err := takeSeats(ctx, tx, event_id, ticketTypeId, seats); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// Reject marks a pending registration as rejected.
+func (r *EventUserRepository) Reject(ctx context.Context, user_id string, event_id int) error {
+	query := "UPDATE event.event_user SET status = 'rejected' WHERE user_id = $1 AND event_id = $2 AND status = 'pending'"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.Reject", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
 	res, err := r.db.ExecContext(ctx, query, user_id, event_id)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return err
 	}
 	i, _ := res.RowsAffected()
@@ -54,23 +415,160 @@ func (r *EventUserRepository) Delete(ctx context.Context, user_id string, event_
 	return nil
 }
 
-func (r *EventUserRepository) GetAllByEvent(ctx context.Context, event_id int) (*[]string, error) {
-	query := "SELECT user_id FROM event.event_user WHERE event_id = $1"
+// CheckIn records the check-in time of an active registration that is not checked in yet.
+func (r *EventUserRepository) CheckIn(ctx context.Context, user_id string, event_id int) (time.Time, error) {
+	query := "UPDATE event.event_user SET checked_in_at = NOW() " +
+		"WHERE user_id = $1 AND event_id = $2 AND status = 'active' AND checked_in_at IS NULL RETURNING checked_in_at"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.CheckIn", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	var checkedInAt time.Time
+	err := r.db.QueryRowContext(ctx, query, user_id, event_id).Scan(&checkedInAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return time.Time{}, err
+	}
+	return checkedInAt, nil
+}
+
+// GetAttendance counts the active registrations of the event, the checked in ones and their guests.
+func (r *EventUserRepository) GetAttendance(ctx context.Context, event_id int) (*models.Attendance, error) {
+	query := "SELECT COUNT(*), COUNT(checked_in_at), COALESCE(SUM(guests), 0) FROM event.event_user WHERE event_id = $1 AND status = 'active'"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.GetAttendance", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	attendance := &models.Attendance{EventId: event_id}
+	err := r.db.QueryRowContext(ctx, query, event_id).Scan(&attendance.Registered, &attendance.CheckedIn, &attendance.Guests)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return attendance, nil
+}
+
+// GetAllByEvent returns the registrations of the event in every status.
+func (r *EventUserRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Registration, error) {
+	query := "SELECT event_id, user_id, status, registered_at, cancelled_at, cancellations, COALESCE(ticket_type_id, 0), checked_in_at, guests, answers " +
+		"FROM event.event_user WHERE event_id = $1 ORDER BY registered_at"
+	ctx, span := tracer.Start(ctx, "EventUserRepository.GetAllByEvent", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
 	rows, err := r.db.QueryContext(ctx, query, event_id)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 	defer rows.Close()
 
-	var users []string
+	registrations := []*models.Registration{}
 
 	for rows.Next() {
-		var id string
-		err := rows.Scan(&id)
+		registration, err := scanRegistration(rows)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return nil, err
 		}
-		users = append(users, id)
+		registrations = append(registrations, registration)
+	}
+
+	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return registrations, nil
+}
+
+// Queries taking the seats of a registration, the guards keep sold and current_attendance
+// within capacity and max_attendees instead of relying on the check constraints.
+const (
+	reserveQuery = "UPDATE event.ticket_types SET sold = sold + $3 WHERE id = $1 AND event_id = $2 AND sold + $3 <= capacity"
+	attendQuery  = "UPDATE event.events SET current_attendance = current_attendance + $2, version = version + 1 " +
+		"WHERE id = $1 AND deleted_at IS NULL AND (max_attendees IS NULL OR current_attendance + $2 <= max_attendees)"
+	existsQuery = "SELECT EXISTS (SELECT 1 FROM event.events WHERE id = $1 AND deleted_at IS NULL)"
+)
+
+// takeSeats takes the seats of the event and of the ticket type, when there is one, in
+// the transaction, negative seats are given back. It fails with ErrSoldOut when the
+// ticket type is full, ErrMaxRegistered when the event is full and ErrRecordNotFound
+// when the event is deleted, any other error is returned as it is.
+func takeSeats(ctx context.Context, tx *sql.Tx, event_id int, ticket_type_id int, seats int) error {
+	span := trace.SpanFromContext(ctx)
+
+	if ticket_type_id != 0 {
+		logger.Query(ctx, reserveQuery)
+		res, err := tx.ExecContext(ctx, reserveQuery, ticket_type_id, event_id, seats)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		i, _ := res.RowsAffected()
+		if i == 0 {
+			return repositories.ErrSoldOut
+		}
+	}
+
+	logger.Query(ctx, attendQuery)
+	res, err := tx.ExecContext(ctx, attendQuery, event_id, seats)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	i, _ := res.RowsAffected()
+	if i != 0 {
+		return nil
+	}
+
+	logger.Query(ctx, existsQuery)
+	var exists bool
+	if err := tx.QueryRowContext(ctx, existsQuery, event_id).Scan(&exists); err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	if !exists {
+		return repositories.ErrRecordNotFound
+	}
+	return repositories.ErrMaxRegistered
+}
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRegistration(row scanner) (*models.Registration, error) {
+	registration := &models.Registration{}
+	var answers []byte
+	err := row.Scan(
+		&registration.EventId,
+		&registration.UserId,
+		&registration.Status,
+		&registration.RegisteredAt,
+		&registration.CancelledAt,
+		&registration.Cancellations,
+		&registration.TicketTypeId,
+		&registration.CheckedInAt,
+		&registration.Guests,
+		&answers,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(answers, &registration.Answers); err != nil {
+		return nil, err
+	}
+	return registration, nil
+}
+
+// marshalAnswers encodes the answers for the answers column, no answers are an empty array.
+func marshalAnswers(answers []models.Answer) ([]byte, error) {
+	if answers == nil {
+		answers = []models.Answer{}
 	}
-	return &users, nil
+	return json.Marshal(answers)
 }
@@ -0,0 +1,151 @@
+package invite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/repositories/invite")
+
+type InviteRepository struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *InviteRepository {
+	return &InviteRepository{
+		db: db,
+	}
+}
+
+// Create stores the invite, an empty user id makes it shareable.
+func (r *InviteRepository) Create(ctx context.Context, invite *models.Invite) error {
+	query := "INSERT INTO event.event_invites (code, event_id, user_id, created_by) VALUES ($1, $2, NULLIF($3, '')::uuid, $4) RETURNING created_at"
+	ctx, span := tracer.Start(ctx, "InviteRepository.Create", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		invite.Code,
+		invite.EventId,
+		invite.UserId,
+		invite.CreatedBy,
+	).Scan(&invite.CreatedAt)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// GetByCode returns the invite of the event with the code, revoked or not.
+func (r *InviteRepository) GetByCode(ctx context.Context, event_id int, code string) (*models.Invite, error) {
+	query := "SELECT code, event_id, COALESCE(user_id::text, ''), created_by, created_at, revoked_at FROM event.event_invites WHERE event_id = $1 AND code = $2"
+	ctx, span := tracer.Start(ctx, "InviteRepository.GetByCode", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	invite := &models.Invite{}
+	err := r.db.QueryRowContext(ctx, query, event_id, code).Scan(
+		&invite.Code,
+		&invite.EventId,
+		&invite.UserId,
+		&invite.CreatedBy,
+		&invite.CreatedAt,
+		&invite.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return invite, nil
+}
+
+// GetAllByEvent returns the invites of the event, revoked ones included, oldest first.
+func (r *InviteRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Invite, error) {
+	query := "SELECT code, event_id, COALESCE(user_id::text, ''), created_by, created_at, revoked_at FROM event.event_invites WHERE event_id = $1 ORDER BY created_at, code"
+	ctx, span := tracer.Start(ctx, "InviteRepository.GetAllByEvent", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, event_id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	invites := []*models.Invite{}
+
+	for rows.Next() {
+		invite := &models.Invite{}
+		err := rows.Scan(
+			&invite.Code,
+			&invite.EventId,
+			&invite.UserId,
+			&invite.CreatedBy,
+			&invite.CreatedAt,
+			&invite.RevokedAt,
+		)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		invites = append(invites, invite)
+	}
+
+	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return invites, nil
+}
+
+// Revoke stops the invite from being used. Registrations made with it are kept.
+func (r *InviteRepository) Revoke(ctx context.Context, event_id int, code string) error {
+	query := "UPDATE event.event_invites SET revoked_at = NOW() WHERE event_id = $1 AND code = $2 AND revoked_at IS NULL"
+	ctx, span := tracer.Start(ctx, "InviteRepository.Revoke", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	res, err := r.db.ExecContext(ctx, query, event_id, code)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	i, _ := res.RowsAffected()
+	if i == 0 {
+		return repositories.ErrRecordNotFound
+	}
+	return nil
+}
+
+// IsInvited reports whether the user has a personal invite to the event that is
+// not revoked or an active or pending registration for it, in which case they may
+// see the event, like visible in the event repository.
+func (r *InviteRepository) IsInvited(ctx context.Context, event_id int, user_id string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM event.event_invites WHERE event_id = $1 AND user_id::text = lower($2) AND revoked_at IS NULL) " +
+		"OR EXISTS (SELECT 1 FROM event.event_user WHERE event_id = $1 AND user_id::text = lower($2) AND status IN ('active', 'pending'))"
+	ctx, span := tracer.Start(ctx, "InviteRepository.IsInvited", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	var invited bool
+	err := r.db.QueryRowContext(ctx, query, event_id, user_id).Scan(&invited)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return false, err
+	}
+	return invited, nil
+}
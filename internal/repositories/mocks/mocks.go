@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/Estriper0/EventService/internal/models"
 	gomock "github.com/golang/mock/gomock"
@@ -36,106 +37,106 @@ func (m *MockIEventRepository) EXPECT() *MockIEventRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockIEventRepository) Create(ctx context.Context, event *models.EventCreateRequest) (int, error) {
+func (m *MockIEventRepository) Create(ctx context.Context, event *models.EventCreateRequest, history *models.EventHistory) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret := m.ctrl.Call(m, "Create", ctx, event, history)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockIEventRepositoryMockRecorder) Create(ctx, event interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) Create(ctx, event, history interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIEventRepository)(nil).Create), ctx, event)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIEventRepository)(nil).Create), ctx, event, history)
 }
 
 // DecreaseCurrentAttedance mocks base method.
-func (m *MockIEventRepository) DecreaseCurrentAttedance(ctx context.Context, event_id int) error {
+func (m *MockIEventRepository) DecreaseCurrentAttedance(ctx context.Context, event_id, seats int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DecreaseCurrentAttedance", ctx, event_id)
+	ret := m.ctrl.Call(m, "DecreaseCurrentAttedance", ctx, event_id, seats)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DecreaseCurrentAttedance indicates an expected call of DecreaseCurrentAttedance.
-func (mr *MockIEventRepositoryMockRecorder) DecreaseCurrentAttedance(ctx, event_id interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) DecreaseCurrentAttedance(ctx, event_id, seats interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecreaseCurrentAttedance", reflect.TypeOf((*MockIEventRepository)(nil).DecreaseCurrentAttedance), ctx, event_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecreaseCurrentAttedance", reflect.TypeOf((*MockIEventRepository)(nil).DecreaseCurrentAttedance), ctx, event_id, seats)
 }
 
 // DeleteById mocks base method.
-func (m *MockIEventRepository) DeleteById(ctx context.Context, id int) error {
+func (m *MockIEventRepository) DeleteById(ctx context.Context, id, version int, history *models.EventHistory) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id, version, history)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteById indicates an expected call of DeleteById.
-func (mr *MockIEventRepositoryMockRecorder) DeleteById(ctx, id interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) DeleteById(ctx, id, version, history interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIEventRepository)(nil).DeleteById), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIEventRepository)(nil).DeleteById), ctx, id, version, history)
 }
 
 // GetAll mocks base method.
-func (m *MockIEventRepository) GetAll(ctx context.Context) ([]*models.EventResponse, error) {
+func (m *MockIEventRepository) GetAll(ctx context.Context, viewer *models.Viewer) ([]*models.EventResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret := m.ctrl.Call(m, "GetAll", ctx, viewer)
 	ret0, _ := ret[0].([]*models.EventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockIEventRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) GetAll(ctx, viewer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIEventRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIEventRepository)(nil).GetAll), ctx, viewer)
 }
 
 // GetAllByCreator mocks base method.
-func (m *MockIEventRepository) GetAllByCreator(ctx context.Context, creator string) ([]*models.EventResponse, error) {
+func (m *MockIEventRepository) GetAllByCreator(ctx context.Context, creator string, organized bool, viewer *models.Viewer) ([]*models.EventResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllByCreator", ctx, creator)
+	ret := m.ctrl.Call(m, "GetAllByCreator", ctx, creator, organized, viewer)
 	ret0, _ := ret[0].([]*models.EventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllByCreator indicates an expected call of GetAllByCreator.
-func (mr *MockIEventRepositoryMockRecorder) GetAllByCreator(ctx, creator interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) GetAllByCreator(ctx, creator, organized, viewer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCreator", reflect.TypeOf((*MockIEventRepository)(nil).GetAllByCreator), ctx, creator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCreator", reflect.TypeOf((*MockIEventRepository)(nil).GetAllByCreator), ctx, creator, organized, viewer)
 }
 
 // GetAllByStatus mocks base method.
-func (m *MockIEventRepository) GetAllByStatus(ctx context.Context, status string) ([]*models.EventResponse, error) {
+func (m *MockIEventRepository) GetAllByStatus(ctx context.Context, status string, viewer *models.Viewer) ([]*models.EventResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllByStatus", ctx, status)
+	ret := m.ctrl.Call(m, "GetAllByStatus", ctx, status, viewer)
 	ret0, _ := ret[0].([]*models.EventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllByStatus indicates an expected call of GetAllByStatus.
-func (mr *MockIEventRepositoryMockRecorder) GetAllByStatus(ctx, status interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) GetAllByStatus(ctx, status, viewer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByStatus", reflect.TypeOf((*MockIEventRepository)(nil).GetAllByStatus), ctx, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByStatus", reflect.TypeOf((*MockIEventRepository)(nil).GetAllByStatus), ctx, status, viewer)
 }
 
 // GetAllByUser mocks base method.
-func (m *MockIEventRepository) GetAllByUser(ctx context.Context, user_id string) ([]*models.EventResponse, error) {
+func (m *MockIEventRepository) GetAllByUser(ctx context.Context, user_id string, viewer *models.Viewer) ([]*models.EventRegistration, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllByUser", ctx, user_id)
-	ret0, _ := ret[0].([]*models.EventResponse)
+	ret := m.ctrl.Call(m, "GetAllByUser", ctx, user_id, viewer)
+	ret0, _ := ret[0].([]*models.EventRegistration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllByUser indicates an expected call of GetAllByUser.
-func (mr *MockIEventRepositoryMockRecorder) GetAllByUser(ctx, user_id interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) GetAllByUser(ctx, user_id, viewer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockIEventRepository)(nil).GetAllByUser), ctx, user_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockIEventRepository)(nil).GetAllByUser), ctx, user_id, viewer)
 }
 
 // GetById mocks base method.
@@ -153,32 +154,78 @@ func (mr *MockIEventRepositoryMockRecorder) GetById(ctx, id interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIEventRepository)(nil).GetById), ctx, id)
 }
 
+// GetDeletedById mocks base method.
+func (m *MockIEventRepository) GetDeletedById(ctx context.Context, id int) (*models.EventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedById", ctx, id)
+	ret0, _ := ret[0].(*models.EventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedById indicates an expected call of GetDeletedById.
+func (mr *MockIEventRepositoryMockRecorder) GetDeletedById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedById", reflect.TypeOf((*MockIEventRepository)(nil).GetDeletedById), ctx, id)
+}
+
 // IncreaseCurrentAttedance mocks base method.
-func (m *MockIEventRepository) IncreaseCurrentAttedance(ctx context.Context, event_id int) error {
+func (m *MockIEventRepository) IncreaseCurrentAttedance(ctx context.Context, event_id, seats int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncreaseCurrentAttedance", ctx, event_id)
+	ret := m.ctrl.Call(m, "IncreaseCurrentAttedance", ctx, event_id, seats)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // IncreaseCurrentAttedance indicates an expected call of IncreaseCurrentAttedance.
-func (mr *MockIEventRepositoryMockRecorder) IncreaseCurrentAttedance(ctx, event_id interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) IncreaseCurrentAttedance(ctx, event_id, seats interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseCurrentAttedance", reflect.TypeOf((*MockIEventRepository)(nil).IncreaseCurrentAttedance), ctx, event_id, seats)
+}
+
+// Purge mocks base method.
+func (m *MockIEventRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockIEventRepositoryMockRecorder) Purge(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockIEventRepository)(nil).Purge), ctx, before)
+}
+
+// Restore mocks base method.
+func (m *MockIEventRepository) Restore(ctx context.Context, id int, history *models.EventHistory) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, history)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockIEventRepositoryMockRecorder) Restore(ctx, id, history interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseCurrentAttedance", reflect.TypeOf((*MockIEventRepository)(nil).IncreaseCurrentAttedance), ctx, event_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockIEventRepository)(nil).Restore), ctx, id, history)
 }
 
 // Update mocks base method.
-func (m *MockIEventRepository) Update(ctx context.Context, event *models.EventUpdateRequest) error {
+func (m *MockIEventRepository) Update(ctx context.Context, event *models.EventUpdateRequest, history *models.EventHistory) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, event)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Update", ctx, event, history)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockIEventRepositoryMockRecorder) Update(ctx, event interface{}) *gomock.Call {
+func (mr *MockIEventRepositoryMockRecorder) Update(ctx, event, history interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIEventRepository)(nil).Update), ctx, event)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIEventRepository)(nil).Update), ctx, event, history)
 }
 
 // MockIEventUserRepository is a mock of IEventUserRepository interface.
@@ -204,32 +251,76 @@ func (m *MockIEventUserRepository) EXPECT() *MockIEventUserRepositoryMockRecorde
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockIEventUserRepository) Create(ctx context.Context, user_id string, event_id int) error {
+// Approve mocks base method.
+func (m *MockIEventUserRepository) Approve(ctx context.Context, user_id string, event_id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, user_id, event_id)
+	ret := m.ctrl.Call(m, "Approve", ctx, user_id, event_id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockIEventUserRepositoryMockRecorder) Create(ctx, user_id, event_id interface{}) *gomock.Call {
+// Approve indicates an expected call of Approve.
+func (mr *MockIEventUserRepositoryMockRecorder) Approve(ctx, user_id, event_id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIEventUserRepository)(nil).Create), ctx, user_id, event_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockIEventUserRepository)(nil).Approve), ctx, user_id, event_id)
 }
 
-// Delete mocks base method.
-func (m *MockIEventUserRepository) Delete(ctx context.Context, user_id string, event_id int) error {
+// Cancel mocks base method.
+func (m *MockIEventUserRepository) Cancel(ctx context.Context, user_id string, event_id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, user_id, event_id)
+	ret := m.ctrl.Call(m, "Cancel", ctx, user_id, event_id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockIEventUserRepositoryMockRecorder) Delete(ctx, user_id, event_id interface{}) *gomock.Call {
+// Cancel indicates an expected call of Cancel.
+func (mr *MockIEventUserRepositoryMockRecorder) Cancel(ctx, user_id, event_id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIEventUserRepository)(nil).Delete), ctx, user_id, event_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockIEventUserRepository)(nil).Cancel), ctx, user_id, event_id)
+}
+
+// CheckIn mocks base method.
+func (m *MockIEventUserRepository) CheckIn(ctx context.Context, user_id string, event_id int) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, user_id, event_id)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockIEventUserRepositoryMockRecorder) CheckIn(ctx, user_id, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockIEventUserRepository)(nil).CheckIn), ctx, user_id, event_id)
+}
+
+// Create mocks base method.
+func (m *MockIEventUserRepository) Create(ctx context.Context, registration *models.Registration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, registration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIEventUserRepositoryMockRecorder) Create(ctx, registration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIEventUserRepository)(nil).Create), ctx, registration)
+}
+
+// CreateGroup mocks base method.
+func (m *MockIEventUserRepository) CreateGroup(ctx context.Context, registration *models.Registration, user_ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, registration, user_ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockIEventUserRepositoryMockRecorder) CreateGroup(ctx, registration, user_ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockIEventUserRepository)(nil).CreateGroup), ctx, registration, user_ids)
 }
 
 // Exists mocks base method.
@@ -247,11 +338,26 @@ func (mr *MockIEventUserRepositoryMockRecorder) Exists(ctx, user_id, event_id in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockIEventUserRepository)(nil).Exists), ctx, user_id, event_id)
 }
 
+// Get mocks base method.
+func (m *MockIEventUserRepository) Get(ctx context.Context, user_id string, event_id int) (*models.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, user_id, event_id)
+	ret0, _ := ret[0].(*models.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIEventUserRepositoryMockRecorder) Get(ctx, user_id, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIEventUserRepository)(nil).Get), ctx, user_id, event_id)
+}
+
 // GetAllByEvent mocks base method.
-func (m *MockIEventUserRepository) GetAllByEvent(ctx context.Context, event_id int) (*[]string, error) {
+func (m *MockIEventUserRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Registration, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllByEvent", ctx, event_id)
-	ret0, _ := ret[0].(*[]string)
+	ret0, _ := ret[0].([]*models.Registration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -261,3 +367,482 @@ func (mr *MockIEventUserRepositoryMockRecorder) GetAllByEvent(ctx, event_id inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByEvent", reflect.TypeOf((*MockIEventUserRepository)(nil).GetAllByEvent), ctx, event_id)
 }
+
+// GetAttendance mocks base method.
+func (m *MockIEventUserRepository) GetAttendance(ctx context.Context, event_id int) (*models.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendance", ctx, event_id)
+	ret0, _ := ret[0].(*models.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendance indicates an expected call of GetAttendance.
+func (mr *MockIEventUserRepositoryMockRecorder) GetAttendance(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendance", reflect.TypeOf((*MockIEventUserRepository)(nil).GetAttendance), ctx, event_id)
+}
+
+// Register mocks base method.
+func (m *MockIEventUserRepository) Register(ctx context.Context, registration *models.Registration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, registration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockIEventUserRepositoryMockRecorder) Register(ctx, registration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIEventUserRepository)(nil).Register), ctx, registration)
+}
+
+// Reject mocks base method.
+func (m *MockIEventUserRepository) Reject(ctx context.Context, user_id string, event_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, user_id, event_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockIEventUserRepositoryMockRecorder) Reject(ctx, user_id, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockIEventUserRepository)(nil).Reject), ctx, user_id, event_id)
+}
+
+// SetGuests mocks base method.
+func (m *MockIEventUserRepository) SetGuests(ctx context.Context, user_id string, event_id, guests int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGuests", ctx, user_id, event_id, guests)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGuests indicates an expected call of SetGuests.
+func (mr *MockIEventUserRepositoryMockRecorder) SetGuests(ctx, user_id, event_id, guests interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGuests", reflect.TypeOf((*MockIEventUserRepository)(nil).SetGuests), ctx, user_id, event_id, guests)
+}
+
+// Transfer mocks base method.
+func (m *MockIEventUserRepository) Transfer(ctx context.Context, event_id int, from, to string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, event_id, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockIEventUserRepositoryMockRecorder) Transfer(ctx, event_id, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockIEventUserRepository)(nil).Transfer), ctx, event_id, from, to)
+}
+
+// MockIEventHistoryRepository is a mock of IEventHistoryRepository interface.
+type MockIEventHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEventHistoryRepositoryMockRecorder
+}
+
+// MockIEventHistoryRepositoryMockRecorder is the mock recorder for MockIEventHistoryRepository.
+type MockIEventHistoryRepositoryMockRecorder struct {
+	mock *MockIEventHistoryRepository
+}
+
+// NewMockIEventHistoryRepository creates a new mock instance.
+func NewMockIEventHistoryRepository(ctrl *gomock.Controller) *MockIEventHistoryRepository {
+	mock := &MockIEventHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockIEventHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEventHistoryRepository) EXPECT() *MockIEventHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIEventHistoryRepository) Create(ctx context.Context, history *models.EventHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIEventHistoryRepositoryMockRecorder) Create(ctx, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIEventHistoryRepository)(nil).Create), ctx, history)
+}
+
+// GetAllByEvent mocks base method.
+func (m *MockIEventHistoryRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.EventHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByEvent", ctx, event_id)
+	ret0, _ := ret[0].([]*models.EventHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByEvent indicates an expected call of GetAllByEvent.
+func (mr *MockIEventHistoryRepositoryMockRecorder) GetAllByEvent(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByEvent", reflect.TypeOf((*MockIEventHistoryRepository)(nil).GetAllByEvent), ctx, event_id)
+}
+
+// GetRevision mocks base method.
+func (m *MockIEventHistoryRepository) GetRevision(ctx context.Context, event_id, revision int) (*models.EventHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, event_id, revision)
+	ret0, _ := ret[0].(*models.EventHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockIEventHistoryRepositoryMockRecorder) GetRevision(ctx, event_id, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockIEventHistoryRepository)(nil).GetRevision), ctx, event_id, revision)
+}
+
+// MockITicketTypeRepository is a mock of ITicketTypeRepository interface.
+type MockITicketTypeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITicketTypeRepositoryMockRecorder
+}
+
+// MockITicketTypeRepositoryMockRecorder is the mock recorder for MockITicketTypeRepository.
+type MockITicketTypeRepositoryMockRecorder struct {
+	mock *MockITicketTypeRepository
+}
+
+// NewMockITicketTypeRepository creates a new mock instance.
+func NewMockITicketTypeRepository(ctrl *gomock.Controller) *MockITicketTypeRepository {
+	mock := &MockITicketTypeRepository{ctrl: ctrl}
+	mock.recorder = &MockITicketTypeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITicketTypeRepository) EXPECT() *MockITicketTypeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITicketTypeRepository) Create(ctx context.Context, ticketType *models.TicketType) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ticketType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITicketTypeRepositoryMockRecorder) Create(ctx, ticketType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITicketTypeRepository)(nil).Create), ctx, ticketType)
+}
+
+// GetAllByEvent mocks base method.
+func (m *MockITicketTypeRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.TicketType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByEvent", ctx, event_id)
+	ret0, _ := ret[0].([]*models.TicketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByEvent indicates an expected call of GetAllByEvent.
+func (mr *MockITicketTypeRepositoryMockRecorder) GetAllByEvent(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByEvent", reflect.TypeOf((*MockITicketTypeRepository)(nil).GetAllByEvent), ctx, event_id)
+}
+
+// GetById mocks base method.
+func (m *MockITicketTypeRepository) GetById(ctx context.Context, id int) (*models.TicketType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(*models.TicketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockITicketTypeRepositoryMockRecorder) GetById(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockITicketTypeRepository)(nil).GetById), ctx, id)
+}
+
+// Reserve mocks base method.
+func (m *MockITicketTypeRepository) Reserve(ctx context.Context, id, event_id, seats int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, id, event_id, seats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockITicketTypeRepositoryMockRecorder) Reserve(ctx, id, event_id, seats interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockITicketTypeRepository)(nil).Reserve), ctx, id, event_id, seats)
+}
+
+// MockIQuestionRepository is a mock of IQuestionRepository interface.
+type MockIQuestionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQuestionRepositoryMockRecorder
+}
+
+// MockIQuestionRepositoryMockRecorder is the mock recorder for MockIQuestionRepository.
+type MockIQuestionRepositoryMockRecorder struct {
+	mock *MockIQuestionRepository
+}
+
+// NewMockIQuestionRepository creates a new mock instance.
+func NewMockIQuestionRepository(ctrl *gomock.Controller) *MockIQuestionRepository {
+	mock := &MockIQuestionRepository{ctrl: ctrl}
+	mock.recorder = &MockIQuestionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQuestionRepository) EXPECT() *MockIQuestionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIQuestionRepository) Create(ctx context.Context, question *models.Question) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, question)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIQuestionRepositoryMockRecorder) Create(ctx, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIQuestionRepository)(nil).Create), ctx, question)
+}
+
+// Delete mocks base method.
+func (m *MockIQuestionRepository) Delete(ctx context.Context, id, event_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, event_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIQuestionRepositoryMockRecorder) Delete(ctx, id, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIQuestionRepository)(nil).Delete), ctx, id, event_id)
+}
+
+// GetAllByEvent mocks base method.
+func (m *MockIQuestionRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByEvent", ctx, event_id)
+	ret0, _ := ret[0].([]*models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByEvent indicates an expected call of GetAllByEvent.
+func (mr *MockIQuestionRepositoryMockRecorder) GetAllByEvent(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByEvent", reflect.TypeOf((*MockIQuestionRepository)(nil).GetAllByEvent), ctx, event_id)
+}
+
+// MockIInviteRepository is a mock of IInviteRepository interface.
+type MockIInviteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIInviteRepositoryMockRecorder
+}
+
+// MockIInviteRepositoryMockRecorder is the mock recorder for MockIInviteRepository.
+type MockIInviteRepositoryMockRecorder struct {
+	mock *MockIInviteRepository
+}
+
+// NewMockIInviteRepository creates a new mock instance.
+func NewMockIInviteRepository(ctrl *gomock.Controller) *MockIInviteRepository {
+	mock := &MockIInviteRepository{ctrl: ctrl}
+	mock.recorder = &MockIInviteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIInviteRepository) EXPECT() *MockIInviteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIInviteRepository) Create(ctx context.Context, invite *models.Invite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, invite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIInviteRepositoryMockRecorder) Create(ctx, invite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIInviteRepository)(nil).Create), ctx, invite)
+}
+
+// GetAllByEvent mocks base method.
+func (m *MockIInviteRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByEvent", ctx, event_id)
+	ret0, _ := ret[0].([]*models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByEvent indicates an expected call of GetAllByEvent.
+func (mr *MockIInviteRepositoryMockRecorder) GetAllByEvent(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByEvent", reflect.TypeOf((*MockIInviteRepository)(nil).GetAllByEvent), ctx, event_id)
+}
+
+// GetByCode mocks base method.
+func (m *MockIInviteRepository) GetByCode(ctx context.Context, event_id int, code string) (*models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, event_id, code)
+	ret0, _ := ret[0].(*models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockIInviteRepositoryMockRecorder) GetByCode(ctx, event_id, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockIInviteRepository)(nil).GetByCode), ctx, event_id, code)
+}
+
+// IsInvited mocks base method.
+func (m *MockIInviteRepository) IsInvited(ctx context.Context, event_id int, user_id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInvited", ctx, event_id, user_id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsInvited indicates an expected call of IsInvited.
+func (mr *MockIInviteRepositoryMockRecorder) IsInvited(ctx, event_id, user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInvited", reflect.TypeOf((*MockIInviteRepository)(nil).IsInvited), ctx, event_id, user_id)
+}
+
+// Revoke mocks base method.
+func (m *MockIInviteRepository) Revoke(ctx context.Context, event_id int, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, event_id, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockIInviteRepositoryMockRecorder) Revoke(ctx, event_id, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIInviteRepository)(nil).Revoke), ctx, event_id, code)
+}
+
+// MockIOrganizerRepository is a mock of IOrganizerRepository interface.
+type MockIOrganizerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOrganizerRepositoryMockRecorder
+}
+
+// MockIOrganizerRepositoryMockRecorder is the mock recorder for MockIOrganizerRepository.
+type MockIOrganizerRepositoryMockRecorder struct {
+	mock *MockIOrganizerRepository
+}
+
+// NewMockIOrganizerRepository creates a new mock instance.
+func NewMockIOrganizerRepository(ctrl *gomock.Controller) *MockIOrganizerRepository {
+	mock := &MockIOrganizerRepository{ctrl: ctrl}
+	mock.recorder = &MockIOrganizerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOrganizerRepository) EXPECT() *MockIOrganizerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockIOrganizerRepository) Add(ctx context.Context, organizer *models.Organizer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, organizer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockIOrganizerRepositoryMockRecorder) Add(ctx, organizer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIOrganizerRepository)(nil).Add), ctx, organizer)
+}
+
+// GetAllByEvent mocks base method.
+func (m *MockIOrganizerRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Organizer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByEvent", ctx, event_id)
+	ret0, _ := ret[0].([]*models.Organizer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByEvent indicates an expected call of GetAllByEvent.
+func (mr *MockIOrganizerRepositoryMockRecorder) GetAllByEvent(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByEvent", reflect.TypeOf((*MockIOrganizerRepository)(nil).GetAllByEvent), ctx, event_id)
+}
+
+// GetRole mocks base method.
+func (m *MockIOrganizerRepository) GetRole(ctx context.Context, event_id int, user_id string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, event_id, user_id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockIOrganizerRepositoryMockRecorder) GetRole(ctx, event_id, user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockIOrganizerRepository)(nil).GetRole), ctx, event_id, user_id)
+}
+
+// Remove mocks base method.
+func (m *MockIOrganizerRepository) Remove(ctx context.Context, event_id int, user_id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, event_id, user_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIOrganizerRepositoryMockRecorder) Remove(ctx, event_id, user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIOrganizerRepository)(nil).Remove), ctx, event_id, user_id)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockIOrganizerRepository) TransferOwnership(ctx context.Context, event_id int, to string, history *models.EventHistory) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", ctx, event_id, to, history)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockIOrganizerRepositoryMockRecorder) TransferOwnership(ctx, event_id, to, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockIOrganizerRepository)(nil).TransferOwnership), ctx, event_id, to, history)
+}
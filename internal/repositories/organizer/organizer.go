@@ -0,0 +1,183 @@
+package organizer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	eventhistory "github.com/Estriper0/EventService/internal/repositories/event_history"
+	"github.com/Estriper0/EventService/internal/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/repositories/organizer")
+
+type OrganizerRepository struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *OrganizerRepository {
+	return &OrganizerRepository{
+		db: db,
+	}
+}
+
+// Add makes the user an organizer of the event or changes the role of an organizer.
+// The role of the owner cannot be changed, which fails with ErrAlreadyExists.
+func (r *OrganizerRepository) Add(ctx context.Context, organizer *models.Organizer) error {
+	query := "INSERT INTO event.event_organizers (event_id, user_id, role) VALUES ($1, $2, $3) " +
+		"ON CONFLICT (event_id, user_id) DO UPDATE SET role = EXCLUDED.role WHERE event_organizers.role <> 'owner' RETURNING added_at"
+	ctx, span := tracer.Start(ctx, "OrganizerRepository.Add", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		organizer.EventId,
+		organizer.UserId,
+		organizer.Role,
+	).Scan(&organizer.AddedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repositories.ErrAlreadyExists
+		}
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// Remove removes an organizer of the event other than the owner.
+func (r *OrganizerRepository) Remove(ctx context.Context, event_id int, user_id string) error {
+	query := "DELETE FROM event.event_organizers WHERE event_id = $1 AND user_id::text = lower($2) AND role <> 'owner'"
+	ctx, span := tracer.Start(ctx, "OrganizerRepository.Remove", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	res, err := r.db.ExecContext(ctx, query, event_id, user_id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	i, _ := res.RowsAffected()
+	if i == 0 {
+		return repositories.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetRole returns the role of the user among the organizers of the event.
+func (r *OrganizerRepository) GetRole(ctx context.Context, event_id int, user_id string) (string, error) {
+	query := "SELECT role FROM event.event_organizers WHERE event_id = $1 AND user_id::text = lower($2)"
+	ctx, span := tracer.Start(ctx, "OrganizerRepository.GetRole", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	var role string
+	err := r.db.QueryRowContext(ctx, query, event_id, user_id).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return "", err
+	}
+	return role, nil
+}
+
+// GetAllByEvent returns the organizers of the event, the owner first.
+func (r *OrganizerRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Organizer, error) {
+	query := "SELECT event_id, user_id, role, added_at FROM event.event_organizers WHERE event_id = $1 ORDER BY role, added_at"
+	ctx, span := tracer.Start(ctx, "OrganizerRepository.GetAllByEvent", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, event_id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	organizers := []*models.Organizer{}
+
+	for rows.Next() {
+		organizer := &models.Organizer{}
+		err := rows.Scan(
+			&organizer.EventId,
+			&organizer.UserId,
+			&organizer.Role,
+			&organizer.AddedAt,
+		)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		organizers = append(organizers, organizer)
+	}
+
+	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return organizers, nil
+}
+
+// TransferOwnership makes the user the creator and owner of the event, the previous
+// owner stays an organizer as an editor. It returns the new version of the event.
+// The history entry, when not nil, is completed with it and written in the same transaction.
+func (r *OrganizerRepository) TransferOwnership(ctx context.Context, event_id int, to string, history *models.EventHistory) (int, error) {
+	creator := "UPDATE event.events SET creator = $2, version = version + 1 WHERE id = $1 AND deleted_at IS NULL RETURNING version"
+	demote := "UPDATE event.event_organizers SET role = 'editor' WHERE event_id = $1 AND role = 'owner' AND user_id <> $2"
+	promote := "INSERT INTO event.event_organizers (event_id, user_id, role) VALUES ($1, $2, 'owner') " +
+		"ON CONFLICT (event_id, user_id) DO UPDATE SET role = 'owner'"
+	ctx, span := tracer.Start(ctx, "OrganizerRepository.TransferOwnership", tracing.DB(creator+"; "+demote+"; "+promote))
+	defer span.End()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	logger.Query(ctx, creator)
+	var version int
+	err = tx.QueryRowContext(ctx, creator, event_id, to).Scan(&version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+
+	for _, query := range []string{demote, promote} {
+		logger.Query(ctx, query)
+		if _, err := tx.ExecContext(ctx, query, event_id, to); err != nil {
+			tracing.RecordError(span, err)
+			return 0, err
+		}
+	}
+
+	if history != nil {
+		history.EventId = event_id
+		history.Revision = version
+		history.Snapshot.Id = event_id
+		history.Snapshot.Version = version
+		if err := eventhistory.Insert(ctx, tx, history); err != nil {
+			tracing.RecordError(span, err)
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return version, nil
+}
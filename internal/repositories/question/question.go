@@ -0,0 +1,115 @@
+package question
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/tracing"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/repositories/question")
+
+type QuestionRepository struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *QuestionRepository {
+	return &QuestionRepository{
+		db: db,
+	}
+}
+
+// Create adds a question to the registration form of the event.
+func (r *QuestionRepository) Create(ctx context.Context, question *models.Question) (int, error) {
+	query := "INSERT INTO event.registration_questions (event_id, position, label, type, options, required) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id"
+	ctx, span := tracer.Start(ctx, "QuestionRepository.Create", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	options := question.Options
+	if options == nil {
+		options = []string{}
+	}
+	var id int
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		question.EventId,
+		question.Position,
+		question.Label,
+		question.Type,
+		pq.Array(options),
+		question.Required,
+	).Scan(&id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// GetAllByEvent returns the registration form of the event in the order of its questions.
+func (r *QuestionRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.Question, error) {
+	query := "SELECT id, event_id, position, label, type, options, required FROM event.registration_questions WHERE event_id = $1 ORDER BY position, id"
+	ctx, span := tracer.Start(ctx, "QuestionRepository.GetAllByEvent", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, event_id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions := []*models.Question{}
+
+	for rows.Next() {
+		question := &models.Question{}
+		err := rows.Scan(
+			&question.Id,
+			&question.EventId,
+			&question.Position,
+			&question.Label,
+			&question.Type,
+			pq.Array(&question.Options),
+			&question.Required,
+		)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		questions = append(questions, question)
+	}
+
+	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return questions, nil
+}
+
+// Delete removes the question from the registration form of the event. The answers
+// already given to it are kept with the registrations.
+func (r *QuestionRepository) Delete(ctx context.Context, id int, event_id int) error {
+	query := "DELETE FROM event.registration_questions WHERE id = $1 AND event_id = $2"
+	ctx, span := tracer.Start(ctx, "QuestionRepository.Delete", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	res, err := r.db.ExecContext(ctx, query, id, event_id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	i, _ := res.RowsAffected()
+	if i == 0 {
+		return repositories.ErrRecordNotFound
+	}
+	return nil
+}
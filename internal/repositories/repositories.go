@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/Estriper0/EventService/internal/models"
 )
@@ -14,38 +15,62 @@ type IEventRepository interface {
 	Create(
 		ctx context.Context,
 		event *models.EventCreateRequest,
+		history *models.EventHistory,
 	) (int, error)
 	GetAll(
 		ctx context.Context,
+		viewer *models.Viewer,
 	) ([]*models.EventResponse, error)
 	GetAllByCreator(
 		ctx context.Context,
 		creator string,
+		organized bool,
+		viewer *models.Viewer,
 	) ([]*models.EventResponse, error)
 	GetAllByStatus(
 		ctx context.Context,
 		status string,
+		viewer *models.Viewer,
 	) ([]*models.EventResponse, error)
 	DeleteById(
 		ctx context.Context,
 		id int,
+		version int,
+		history *models.EventHistory,
 	) error
 	Update(
 		ctx context.Context,
 		event *models.EventUpdateRequest,
-	) error
+		history *models.EventHistory,
+	) (int, error)
 	IncreaseCurrentAttedance(
 		ctx context.Context,
 		event_id int,
+		seats int,
 	) error
 	DecreaseCurrentAttedance(
 		ctx context.Context,
 		event_id int,
+		seats int,
 	) error
 	GetAllByUser(
 		ctx context.Context,
 		user_id string,
-	) ([]*models.EventResponse, error)
+		viewer *models.Viewer,
+	) ([]*models.EventRegistration, error)
+	GetDeletedById(
+		ctx context.Context,
+		id int,
+	) (*models.EventResponse, error)
+	Restore(
+		ctx context.Context,
+		id int,
+		history *models.EventHistory,
+	) (int, error)
+	Purge(
+		ctx context.Context,
+		before time.Time,
+	) (int64, error)
 }
 
 type IEventUserRepository interface {
@@ -54,18 +79,168 @@ type IEventUserRepository interface {
 		user_id string,
 		event_id int,
 	) (bool, error)
+	Get(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+	) (*models.Registration, error)
 	Create(
+		ctx context.Context,
+		registration *models.Registration,
+	) error
+	Register(
+		ctx context.Context,
+		registration *models.Registration,
+	) error
+	CreateGroup(
+		ctx context.Context,
+		registration *models.Registration,
+		user_ids []string,
+	) ([]string, error)
+	Cancel(
 		ctx context.Context,
 		user_id string,
 		event_id int,
 	) error
+	Approve(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+	) error
+	Reject(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+	) error
+	Transfer(
+		ctx context.Context,
+		event_id int,
+		from string,
+		to string,
+	) error
+	SetGuests(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+		guests int,
+	) error
+	CheckIn(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+	) (time.Time, error)
+	GetAttendance(
+		ctx context.Context,
+		event_id int,
+	) (*models.Attendance, error)
+	GetAllByEvent(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.Registration, error)
+}
+
+type IEventHistoryRepository interface {
+	Create(
+		ctx context.Context,
+		history *models.EventHistory,
+	) error
+	GetAllByEvent(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.EventHistory, error)
+	GetRevision(
+		ctx context.Context,
+		event_id int,
+		revision int,
+	) (*models.EventHistory, error)
+}
+
+type ITicketTypeRepository interface {
+	Create(
+		ctx context.Context,
+		ticketType *models.TicketType,
+	) (int, error)
+	GetById(
+		ctx context.Context,
+		id int,
+	) (*models.TicketType, error)
+	GetAllByEvent(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.TicketType, error)
+	Reserve(
+		ctx context.Context,
+		id int,
+		event_id int,
+		seats int,
+	) error
+}
+
+type IQuestionRepository interface {
+	Create(
+		ctx context.Context,
+		question *models.Question,
+	) (int, error)
+	GetAllByEvent(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.Question, error)
 	Delete(
 		ctx context.Context,
+		id int,
+		event_id int,
+	) error
+}
+
+type IInviteRepository interface {
+	Create(
+		ctx context.Context,
+		invite *models.Invite,
+	) error
+	GetByCode(
+		ctx context.Context,
+		event_id int,
+		code string,
+	) (*models.Invite, error)
+	GetAllByEvent(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.Invite, error)
+	Revoke(
+		ctx context.Context,
+		event_id int,
+		code string,
+	) error
+	IsInvited(
+		ctx context.Context,
+		event_id int,
 		user_id string,
+	) (bool, error)
+}
+
+type IOrganizerRepository interface {
+	Add(
+		ctx context.Context,
+		organizer *models.Organizer,
+	) error
+	Remove(
+		ctx context.Context,
 		event_id int,
+		user_id string,
 	) error
+	GetRole(
+		ctx context.Context,
+		event_id int,
+		user_id string,
+	) (string, error)
 	GetAllByEvent(
 		ctx context.Context,
 		event_id int,
-	) (*[]string, error)
+	) ([]*models.Organizer, error)
+	TransferOwnership(
+		ctx context.Context,
+		event_id int,
+		to string,
+		history *models.EventHistory,
+	) (int, error)
 }
@@ -0,0 +1,150 @@
+package tickettype
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/tracing"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/repositories/ticket_type")
+
+type TicketTypeRepository struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *TicketTypeRepository {
+	return &TicketTypeRepository{
+		db: db,
+	}
+}
+
+// Create adds a ticket type to the event, the name must be unique within the event.
+func (r *TicketTypeRepository) Create(ctx context.Context, ticketType *models.TicketType) (int, error) {
+	query := "INSERT INTO event.ticket_types (event_id, name, capacity, sale_starts_at, sale_ends_at, hidden) VALUES ($1, $2, $3, $4, $5, $6) " +
+		"ON CONFLICT (event_id, name) DO NOTHING RETURNING id"
+	ctx, span := tracer.Start(ctx, "TicketTypeRepository.Create", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	var id int
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		ticketType.EventId,
+		ticketType.Name,
+		ticketType.Capacity,
+		ticketType.SaleStartsAt,
+		ticketType.SaleEndsAt,
+		ticketType.Hidden,
+	).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, repositories.ErrAlreadyExists
+		}
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *TicketTypeRepository) GetById(ctx context.Context, id int) (*models.TicketType, error) {
+	query := "SELECT id, event_id, name, capacity, sold, sale_starts_at, sale_ends_at, hidden FROM event.ticket_types WHERE id = $1"
+	ctx, span := tracer.Start(ctx, "TicketTypeRepository.GetById", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	ticketType, err := scanTicketType(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrRecordNotFound
+		}
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return ticketType, nil
+}
+
+// GetAllByEvent returns the ticket types of the event, hidden ones included.
+func (r *TicketTypeRepository) GetAllByEvent(ctx context.Context, event_id int) ([]*models.TicketType, error) {
+	query := "SELECT id, event_id, name, capacity, sold, sale_starts_at, sale_ends_at, hidden FROM event.ticket_types WHERE event_id = $1 ORDER BY id"
+	ctx, span := tracer.Start(ctx, "TicketTypeRepository.GetAllByEvent", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	rows, err := r.db.QueryContext(ctx, query, event_id)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	ticketTypes := []*models.TicketType{}
+
+	for rows.Next() {
+		ticketType, err := scanTicketType(rows)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		ticketTypes = append(ticketTypes, ticketType)
+	}
+
+	if err := rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return ticketTypes, nil
+}
+
+// Reserve takes the seats of the ticket type and of the event in one statement.
+// It returns ErrSoldOut when the ticket type is full and ErrMaxRegistered
+// when the event is.
+func (r *TicketTypeRepository) Reserve(ctx context.Context, id int, event_id int, seats int) error {
+	query := "WITH seat AS (" +
+		"UPDATE event.ticket_types SET sold = sold + $3 WHERE id = $1 AND event_id = $2 AND sold + $3 <= capacity RETURNING event_id" +
+		") UPDATE event.events SET current_attendance = current_attendance + $3, version = version + 1 " +
+		"WHERE id IN (SELECT event_id FROM seat) AND deleted_at IS NULL"
+	ctx, span := tracer.Start(ctx, "TicketTypeRepository.Reserve", tracing.DB(query))
+	defer span.End()
+	logger.Query(ctx, query)
+
+	res, err := r.db.ExecContext(ctx, query, id, event_id, seats)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return repositories.ErrMaxRegistered
+	}
+	i, _ := res.RowsAffected()
+	if i == 0 {
+		return repositories.ErrSoldOut
+	}
+	return nil
+}
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTicketType(row scanner) (*models.TicketType, error) {
+	ticketType := &models.TicketType{}
+	err := row.Scan(
+		&ticketType.Id,
+		&ticketType.EventId,
+		&ticketType.Name,
+		&ticketType.Capacity,
+		&ticketType.Sold,
+		&ticketType.SaleStartsAt,
+		&ticketType.SaleEndsAt,
+		&ticketType.Hidden,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ticketType, nil
+}
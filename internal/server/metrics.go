@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type MetricsServer struct {
+	logger     *slog.Logger
+	config     *config.Config
+	httpServer *http.Server
+}
+
+func NewMetrics(
+	logger *slog.Logger,
+	config *config.Config,
+) *MetricsServer {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return &MetricsServer{
+		logger: logger,
+		config: config,
+		httpServer: &http.Server{
+			Addr:              fmt.Sprintf(":%d", config.Metrics.Port),
+			Handler:           mux,
+			ReadHeaderTimeout: shutdownTimeout,
+		},
+	}
+}
+
+func (s *MetricsServer) Run() {
+	s.logger.Info(
+		"Metrics server is running",
+		slog.Int("port", s.config.Metrics.Port),
+	)
+
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		panic(err)
+	}
+}
+
+func (s *MetricsServer) Stop() {
+	s.logger.Info(
+		"Stopping metrics server",
+		slog.Int("port", s.config.Metrics.Port),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.logger.Error(
+			"Error stopping metrics server",
+			slog.String("err", err.Error()),
+		)
+	}
+}
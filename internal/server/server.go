@@ -4,32 +4,90 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"time"
 
+	"github.com/Estriper0/EventService/api/management"
+	"github.com/Estriper0/EventService/internal/certs"
 	"github.com/Estriper0/EventService/internal/config"
 	event_handler "github.com/Estriper0/EventService/internal/handlers/event"
+	"github.com/Estriper0/EventService/internal/health"
+	"github.com/Estriper0/EventService/internal/interceptors"
+	"github.com/Estriper0/EventService/internal/loadshed"
+	"github.com/Estriper0/EventService/internal/ratelimit"
 	"github.com/Estriper0/EventService/internal/service"
+	pb "github.com/Estriper0/protobuf/gen/event"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type GRPCServer struct {
 	logger     *slog.Logger
 	config     *config.Config
 	grpcServer *grpc.Server
+	health     *health.Checker
+	certs      *certs.Reloader
 }
 
 func New(
 	logger *slog.Logger,
 	config *config.Config,
 	eventService service.IEventService,
+	limiter ratelimit.Limiter,
+	checks ...health.Check,
 ) *GRPCServer {
-	grpcServer := grpc.NewServer()
+	chain := []grpc.UnaryServerInterceptor{
+		interceptors.Metrics(),
+		interceptors.Auth(),
+		interceptors.Logging(logger),
+		interceptors.Recovery(logger),
+	}
+	if config.LoadShed.Enabled {
+		chain = append(chain, interceptors.LoadShed(loadshed.New(&config.LoadShed), logger))
+	}
+	chain = append(
+		chain,
+		interceptors.Deadline(&config.Deadlines),
+		interceptors.RateLimit(limiter, &config.RateLimit, logger),
+	)
+
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(chain...),
+	}
+
+	var reloader *certs.Reloader
+	if config.TLS.Enabled {
+		var err error
+		reloader, err = certs.New(&config.TLS, logger)
+		if err != nil {
+			panic(err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.TLSConfig())))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
 
 	event_handler.Register(grpcServer, eventService)
 
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	checker := health.New(
+		healthServer,
+		[]string{pb.Event_ServiceDesc.ServiceName, management.EventManagement_ServiceDesc.ServiceName},
+		logger,
+		&config.Health,
+		checks...,
+	)
+
 	return &GRPCServer{
 		logger:     logger,
 		config:     config,
 		grpcServer: grpcServer,
+		health:     checker,
+		certs:      reloader,
 	}
 }
 
@@ -37,6 +95,8 @@ func (s *GRPCServer) Run() {
 	s.logger.Info(
 		"Starting gRPC server",
 		slog.Int("port", s.config.Port),
+		slog.Bool("tls", s.config.TLS.Enabled),
+		slog.Bool("mtls", s.config.TLS.Enabled && s.config.TLS.ClientCAFile != ""),
 	)
 
 	l, err := net.Listen("tcp", fmt.Sprintf(":%d", s.config.Port))
@@ -44,6 +104,11 @@ func (s *GRPCServer) Run() {
 		panic(err)
 	}
 
+	go s.health.Run()
+	if s.certs != nil {
+		go s.certs.Run()
+	}
+
 	s.logger.Info(
 		"GRPC server is running",
 		slog.String("addr", l.Addr().String()),
@@ -59,5 +124,11 @@ func (s *GRPCServer) Stop() {
 		slog.Int("port", s.config.Port),
 	)
 
+	s.health.Shutdown()
+	time.Sleep(s.config.Health.DrainDelay)
+
 	s.grpcServer.GracefulStop()
+	if s.certs != nil {
+		s.certs.Stop()
+	}
 }
@@ -3,9 +3,237 @@ package service
 import "errors"
 
 var (
-	ErrRecordNotFound  = errors.New("record not found")
-	ErrRepositoryError = errors.New("error in the repository")
-	ErrRegistered      = errors.New("the user is already registered")
-	ErrNotRegistered   = errors.New("the user is not registered")
-	ErrMaxRegistered   = errors.New("the maximum number of users has been registered")
+	ErrRecordNotFound    = errors.New("record not found")
+	ErrRepositoryError   = errors.New("error in the repository")
+	ErrRegistered        = errors.New("the user is already registered")
+	ErrNotRegistered     = errors.New("the user is not registered")
+	ErrMaxRegistered     = errors.New("the maximum number of users has been registered")
+	ErrUnauthenticated   = errors.New("the caller is not authenticated")
+	ErrPermissionDenied  = errors.New("the caller is not allowed to manage the event")
+	ErrVersionMismatch   = errors.New("the event has been modified since the given version")
+	ErrRestoreExpired    = errors.New("the restore period of the event has expired")
+	ErrPending           = errors.New("the registration is waiting for approval")
+	ErrNotPending        = errors.New("the registration is not waiting for approval")
+	ErrRejected          = errors.New("the registration has been rejected")
+	ErrTicketsDisabled   = errors.New("tickets are not configured")
+	ErrInvalidTicket     = errors.New("the ticket is invalid")
+	ErrCheckedIn         = errors.New("the ticket has already been checked in")
+	ErrSoldOut           = errors.New("the ticket type is sold out")
+	ErrSaleClosed        = errors.New("the ticket type is not on sale")
+	ErrTicketTypeExists  = errors.New("the event already has a ticket type with this name")
+	ErrQuestionNotFound  = errors.New("the question is not part of the registration form of the event")
+	ErrInviteNotFound    = errors.New("the invite does not exist for the event or has been revoked")
+	ErrInviteRequired    = errors.New("the event is private, an invite code is required to register")
+	ErrInvalidInvite     = errors.New("the invite code is not valid for the user and the event")
+	ErrOrganizerNotFound = errors.New("the user is not an organizer of the event")
+
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFailedPrecondition = errors.New("failed precondition")
+)
+
+// RuleError is a violation of a domain rule. It wraps ErrInvalidArgument when
+// the request itself is wrong and ErrFailedPrecondition when it conflicts with
+// the current state of the event.
+type RuleError struct {
+	Field   string
+	Reason  string
+	Message string
+	kind    error
+}
+
+func (e *RuleError) Error() string {
+	return e.Message
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.kind
+}
+
+var (
+	ErrStartDateInPast = &RuleError{
+		Field:   "start_date",
+		Reason:  "START_DATE_IN_PAST",
+		Message: "the start date must be in the future",
+		kind:    ErrInvalidArgument,
+	}
+	ErrLocationRequired = &RuleError{
+		Field:   "location",
+		Reason:  "LOCATION_REQUIRED",
+		Message: "the location must not be empty",
+		kind:    ErrInvalidArgument,
+	}
+	ErrCapacityBelowAttendance = &RuleError{
+		Field:   "max_attendees",
+		Reason:  "CAPACITY_BELOW_ATTENDANCE",
+		Message: "the maximum number of attendees is less than the number of registered users",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrTicketTypeRequired = &RuleError{
+		Field:   "ticket_type_id",
+		Reason:  "TICKET_TYPE_REQUIRED",
+		Message: "the event has ticket types, one of them must be chosen",
+		kind:    ErrInvalidArgument,
+	}
+	ErrTicketTypeNotFound = &RuleError{
+		Field:   "ticket_type_id",
+		Reason:  "TICKET_TYPE_NOT_FOUND",
+		Message: "the ticket type does not exist for the event",
+		kind:    ErrInvalidArgument,
+	}
+	ErrTicketTypeNameRequired = &RuleError{
+		Field:   "name",
+		Reason:  "NAME_REQUIRED",
+		Message: "the name of the ticket type must not be empty",
+		kind:    ErrInvalidArgument,
+	}
+	ErrTicketTypeCapacity = &RuleError{
+		Field:   "capacity",
+		Reason:  "INVALID_CAPACITY",
+		Message: "the capacity of the ticket type must be positive",
+		kind:    ErrInvalidArgument,
+	}
+	ErrSaleWindow = &RuleError{
+		Field:   "sale_ends_at",
+		Reason:  "INVALID_SALE_WINDOW",
+		Message: "the sale must end after it starts",
+		kind:    ErrInvalidArgument,
+	}
+	ErrRegistrationWindow = &RuleError{
+		Field:   "registration_closes_at",
+		Reason:  "INVALID_REGISTRATION_WINDOW",
+		Message: "the registration must close after it opens",
+		kind:    ErrInvalidArgument,
+	}
+	ErrCancellationCutoff = &RuleError{
+		Field:   "cancellation_cutoff",
+		Reason:  "INVALID_CANCELLATION_CUTOFF",
+		Message: "the cancellation cut-off must not be negative",
+		kind:    ErrInvalidArgument,
+	}
+	ErrRegistrationNotOpen = &RuleError{
+		Field:   "registration_opens_at",
+		Reason:  "REGISTRATION_NOT_OPEN",
+		Message: "the registration for the event has not opened yet",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrRegistrationClosed = &RuleError{
+		Field:   "registration_closes_at",
+		Reason:  "REGISTRATION_CLOSED",
+		Message: "the registration for the event has closed",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrCancellationClosed = &RuleError{
+		Field:   "cancellation_cutoff",
+		Reason:  "CANCELLATION_CLOSED",
+		Message: "the registration can no longer be cancelled",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrGuestsNegative = &RuleError{
+		Field:   "guests",
+		Reason:  "INVALID_GUESTS",
+		Message: "the number of guests must not be negative",
+		kind:    ErrInvalidArgument,
+	}
+	ErrGroupEmpty = &RuleError{
+		Field:   "user_ids",
+		Reason:  "USERS_REQUIRED",
+		Message: "the group must have at least one user",
+		kind:    ErrInvalidArgument,
+	}
+	ErrGroupTooLarge = &RuleError{
+		Field:   "user_ids",
+		Reason:  "GROUP_TOO_LARGE",
+		Message: "the group has more users than allowed",
+		kind:    ErrInvalidArgument,
+	}
+	ErrTransferToSelf = &RuleError{
+		Field:   "to_user_id",
+		Reason:  "TRANSFER_TO_SELF",
+		Message: "the registration cannot be transferred to the same user",
+		kind:    ErrInvalidArgument,
+	}
+	ErrInvalidRecipient = &RuleError{
+		Field:   "to_user_id",
+		Reason:  "INVALID_USER_ID",
+		Message: "the user id the registration is transferred to must be a UUID",
+		kind:    ErrInvalidArgument,
+	}
+	ErrRecipientNotInvited = &RuleError{
+		Field:   "to_user_id",
+		Reason:  "RECIPIENT_NOT_INVITED",
+		Message: "the event is private, the registration can only be transferred to an invited user",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrTransferNotAllowed = &RuleError{
+		Field:   "allow_transfers",
+		Reason:  "TRANSFERS_NOT_ALLOWED",
+		Message: "the event does not allow transferring registrations",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrTransferClosed = &RuleError{
+		Field:   "start_date",
+		Reason:  "TRANSFER_CLOSED",
+		Message: "the registration can no longer be transferred",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrQuestionLabelRequired = &RuleError{
+		Field:   "label",
+		Reason:  "LABEL_REQUIRED",
+		Message: "the question must have a label",
+		kind:    ErrInvalidArgument,
+	}
+	ErrQuestionType = &RuleError{
+		Field:   "type",
+		Reason:  "INVALID_QUESTION_TYPE",
+		Message: "the question type must be text, single_choice, multi_choice or boolean",
+		kind:    ErrInvalidArgument,
+	}
+	ErrQuestionOptions = &RuleError{
+		Field:   "options",
+		Reason:  "INVALID_OPTIONS",
+		Message: "a choice question must have at least two distinct options and other questions none",
+		kind:    ErrInvalidArgument,
+	}
+	ErrAnswerRequired = &RuleError{
+		Field:   "answers",
+		Reason:  "ANSWER_REQUIRED",
+		Message: "a required question of the registration form is not answered",
+		kind:    ErrInvalidArgument,
+	}
+	ErrInvalidAnswer = &RuleError{
+		Field:   "answers",
+		Reason:  "INVALID_ANSWER",
+		Message: "an answer does not match a question of the registration form",
+		kind:    ErrInvalidArgument,
+	}
+	ErrInvalidInvitee = &RuleError{
+		Field:   "user_id",
+		Reason:  "INVALID_USER_ID",
+		Message: "the invited user id must be a UUID",
+		kind:    ErrInvalidArgument,
+	}
+	ErrOrganizerRole = &RuleError{
+		Field:   "role",
+		Reason:  "INVALID_ROLE",
+		Message: "the role of an organizer must be editor, checkin or viewer",
+		kind:    ErrInvalidArgument,
+	}
+	ErrInvalidOrganizer = &RuleError{
+		Field:   "user_id",
+		Reason:  "INVALID_USER_ID",
+		Message: "the organizer user id must be a UUID",
+		kind:    ErrInvalidArgument,
+	}
+	ErrOwnerRole = &RuleError{
+		Field:   "user_id",
+		Reason:  "OWNER_ROLE",
+		Message: "the owner of the event can only change by transferring the ownership",
+		kind:    ErrFailedPrecondition,
+	}
+	ErrTooManyGuests = &RuleError{
+		Field:   "guests",
+		Reason:  "TOO_MANY_GUESTS",
+		Message: "the number of guests exceeds the maximum allowed by the event",
+		kind:    ErrFailedPrecondition,
+	}
 )
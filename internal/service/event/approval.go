@@ -0,0 +1,180 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/metrics"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetPending returns the registrations of the event waiting for approval, oldest first.
+func (s *EventService) GetPending(ctx context.Context, event_id int) ([]*models.Registration, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetPending", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	registrations, err := s.eventUserRepo.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting pending registrations",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	pending := []*models.Registration{}
+	for _, registration := range registrations {
+		if registration.Status == models.RegistrationPending {
+			pending = append(pending, registration)
+		}
+	}
+	s.log(ctx).Info(
+		"Successful getting pending registrations",
+		slog.Int("event", event_id),
+	)
+	return pending, nil
+}
+
+// Approve activates a pending registration, taking the seats of the user and the guests
+// of the event and of its ticket type.
+func (s *EventService) Approve(ctx context.Context, user_id string, event_id int) error {
+	ctx, span := tracer.Start(ctx, "EventService.Approve", trace.WithAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerEditor); err != nil {
+		return err
+	}
+
+	err := s.eventUserRepo.Approve(ctx, user_id, event_id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Pending registration not found",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrNotPending
+		} else if errors.Is(err, repositories.ErrMaxRegistered) {
+			s.log(ctx).Info(
+				"Maximum number of users",
+			)
+			return service.ErrMaxRegistered
+		} else if errors.Is(err, repositories.ErrSoldOut) {
+			s.log(ctx).Info(
+				"Ticket type is sold out",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrSoldOut
+		}
+		s.log(ctx).Error(
+			"Error approving registration",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.invalidate(ctx, event_id)
+	metrics.Registered()
+	s.log(ctx).Info(
+		"Successful approved registration",
+		slog.String("user_id", user_id),
+		slog.Int("event_id", event_id),
+	)
+	return nil
+}
+
+// Reject declines a pending registration, the user cannot register for the event again.
+func (s *EventService) Reject(ctx context.Context, user_id string, event_id int) error {
+	ctx, span := tracer.Start(ctx, "EventService.Reject", trace.WithAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerEditor); err != nil {
+		return err
+	}
+
+	err := s.eventUserRepo.Reject(ctx, user_id, event_id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Pending registration not found",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrNotPending
+		}
+		s.log(ctx).Error(
+			"Error rejecting registration",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful rejected registration",
+		slog.String("user_id", user_id),
+		slog.Int("event_id", event_id),
+	)
+	return nil
+}
+
+// GetRegistration returns the registration of the user for the event. It is
+// available to the user and to those who can manage the event.
+func (s *EventService) GetRegistration(ctx context.Context, user_id string, event_id int) (*models.Registration, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetRegistration", trace.WithAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if err := s.authorizeRegistration(ctx, user_id, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	registration, err := s.eventUserRepo.Get(ctx, user_id, event_id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"User is not registered",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return nil, service.ErrNotRegistered
+		}
+		s.log(ctx).Error(
+			"Error getting registration",
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful getting registration",
+		slog.String("user_id", user_id),
+		slog.Int("event_id", event_id),
+	)
+	return registration, nil
+}
+
+// authorizeRegistration checks that the caller from ctx is the registered user,
+// an admin or an organizer of the event with at least the given role.
+func (s *EventService) authorizeRegistration(ctx context.Context, user_id string, event_id int, role string) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		s.log(ctx).Warn(
+			"Unauthenticated access to registration",
+			slog.Int("event_id", event_id),
+		)
+		return service.ErrUnauthenticated
+	}
+	if strings.EqualFold(caller.UserId, user_id) || caller.IsAdmin() {
+		return nil
+	}
+	_, err := s.authorize(ctx, event_id, role)
+	return err
+}
@@ -5,103 +5,214 @@ import (
 	"errors"
 	"log/slog"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/Estriper0/EventService/internal/auth"
 	"github.com/Estriper0/EventService/internal/cache"
 	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/logger"
+	"github.com/Estriper0/EventService/internal/metrics"
 	"github.com/Estriper0/EventService/internal/models"
 	"github.com/Estriper0/EventService/internal/repositories"
 	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/ticket"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/Estriper0/EventService/internal/service/event")
+
 type EventService struct {
 	eventRepo     repositories.IEventRepository
 	eventUserRepo repositories.IEventUserRepository
+	historyRepo   repositories.IEventHistoryRepository
+	ticketTypes   repositories.ITicketTypeRepository
+	questions     repositories.IQuestionRepository
+	invites       repositories.IInviteRepository
+	organizers    repositories.IOrganizerRepository
 	cache         cache.Cache
 	logger        *slog.Logger
 	config        *config.Config
+	validation    *validation
+	tickets       *ticket.Signer
 }
 
-func New(repo repositories.IEventRepository, eventUserRepo repositories.IEventUserRepository, cache cache.Cache, logger *slog.Logger, config *config.Config) *EventService {
+func New(
+	repo repositories.IEventRepository,
+	eventUserRepo repositories.IEventUserRepository,
+	historyRepo repositories.IEventHistoryRepository,
+	ticketTypeRepo repositories.ITicketTypeRepository,
+	questionRepo repositories.IQuestionRepository,
+	inviteRepo repositories.IInviteRepository,
+	organizerRepo repositories.IOrganizerRepository,
+	cache cache.Cache,
+	logger *slog.Logger,
+	config *config.Config,
+) *EventService {
 	return &EventService{
 		eventRepo:     repo,
 		eventUserRepo: eventUserRepo,
+		historyRepo:   historyRepo,
+		ticketTypes:   ticketTypeRepo,
+		questions:     questionRepo,
+		invites:       inviteRepo,
+		organizers:    organizerRepo,
 		cache:         cache,
 		logger:        logger,
 		config:        config,
+		validation:    newValidation(&config.Registration),
+		tickets:       ticket.New(config.Tickets.Secret),
 	}
 }
 
+// log returns the request-scoped logger enriched by the logging interceptor.
+func (s *EventService) log(ctx context.Context) *slog.Logger {
+	return logger.FromContext(ctx, s.logger)
+}
+
+// GetAll returns the events visible to the caller.
 func (s *EventService) GetAll(ctx context.Context) ([]*models.EventResponse, error) {
-	events, err := s.eventRepo.GetAll(ctx)
+	ctx, span := tracer.Start(ctx, "EventService.GetAll")
+	defer span.End()
+
+	events, err := s.eventRepo.GetAll(ctx, viewer(ctx))
 	if err != nil {
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error getting all events",
 			slog.String("err", err.Error()),
 		)
 		return nil, service.ErrRepositoryError
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful getting all events",
 	)
 	return events, nil
 }
 
+// Create stores a new event. An authenticated caller can only create events of their own.
 func (s *EventService) Create(ctx context.Context, event *models.EventCreateRequest) (int, error) {
-	id, err := s.eventRepo.Create(ctx, event)
+	ctx, span := tracer.Start(ctx, "EventService.Create")
+	defer span.End()
+
+	if err := s.validation.create(event); err != nil {
+		s.log(ctx).Info(
+			"Invalid event",
+			slog.String("err", err.Error()),
+		)
+		return 0, err
+	}
+	if caller, ok := auth.CallerFromContext(ctx); ok && !strings.EqualFold(caller.UserId, event.Creator) {
+		s.log(ctx).Warn(
+			"Creating an event for another user",
+			slog.String("user_id", caller.UserId),
+			slog.String("creator", event.Creator),
+		)
+		return 0, service.ErrPermissionDenied
+	}
+	if event.Visibility == "" {
+		event.Visibility = models.VisibilityPublic
+	}
+
+	snapshot := &models.EventResponse{
+		Title:        event.Title,
+		About:        event.About,
+		StartDate:    event.StartDate,
+		Location:     event.Location,
+		Status:       event.Status,
+		MaxAttendees: event.MaxAttendees,
+		Creator:      event.Creator,
+
+		RequiresApproval:     event.RequiresApproval,
+		RegistrationOpensAt:  event.RegistrationOpensAt,
+		RegistrationClosesAt: event.RegistrationClosesAt,
+		CancellationCutoff:   event.CancellationCutoff,
+		MaxGuests:            event.MaxGuests,
+		AllowTransfers:       event.AllowTransfers,
+		Visibility:           event.Visibility,
+	}
+	id, err := s.eventRepo.Create(ctx, event, entry(ctx, &models.EventHistory{
+		Action:   models.ActionCreate,
+		Actor:    event.Creator,
+		Changes:  created(snapshot),
+		Snapshot: snapshot,
+	}))
 	if err != nil {
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error create event",
 			slog.String("err", err.Error()),
 		)
 		return 0, service.ErrRepositoryError
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful create event",
 		slog.Int("id", id),
 	)
 	return id, nil
 }
 
+// GetById returns the event if the caller may see it. A private event the caller
+// is not invited to is reported as not found.
 func (s *EventService) GetById(ctx context.Context, id int) (*models.EventResponse, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetById", trace.WithAttributes(attribute.Int("event.id", id)))
+	defer span.End()
+
+	event, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkVisible(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// get returns the event through the cache regardless of its visibility.
+func (s *EventService) get(ctx context.Context, id int) (*models.EventResponse, error) {
 	event, err := s.cache.GetEvent(ctx, id)
 	if err != nil && err != cache.ErrNotFound {
-		s.logger.Error(
+		metrics.CacheRequests.WithLabelValues(metrics.CacheError).Inc()
+		s.log(ctx).Error(
 			"Error in redis getting event",
 			slog.String("error", err.Error()),
 		)
 	} else if err == nil {
+		metrics.CacheRequests.WithLabelValues(metrics.CacheHit).Inc()
 		return event, nil
+	} else {
+		metrics.CacheRequests.WithLabelValues(metrics.CacheMiss).Inc()
 	}
 
 	event, err = s.eventRepo.GetById(ctx, id)
 	if err != nil {
 		if errors.Is(err, repositories.ErrRecordNotFound) {
-			s.logger.Info(
+			s.log(ctx).Info(
 				"Event not found",
 				slog.Int("id", id),
 			)
 			return nil, service.ErrRecordNotFound
 		}
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error getting event",
 			slog.Int("id", id),
 			slog.String("err", err.Error()),
 		)
 		return nil, service.ErrRepositoryError
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful getting event",
 		slog.Int("id", id),
 	)
 
 	err = s.cache.SetEvent(ctx, event, s.config.Redis.CacheTTL)
 	if err != nil {
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error in redis setting event",
 			slog.String("error", err.Error()),
 		)
 	} else {
-		s.logger.Info(
+		s.log(ctx).Info(
 			"Successfully added to cache",
 			slog.Int("id", id),
 		)
@@ -110,199 +221,406 @@ func (s *EventService) GetById(ctx context.Context, id int) (*models.EventRespon
 	return event, nil
 }
 
-func (s *EventService) DeleteById(ctx context.Context, id int) error {
-	err := s.eventRepo.DeleteById(ctx, id)
+// DeleteById deletes the event softly, it can be restored during the restore period.
+// A non-zero version must match the current version of the event.
+func (s *EventService) DeleteById(ctx context.Context, id int, version int) error {
+	ctx, span := tracer.Start(ctx, "EventService.DeleteById", trace.WithAttributes(attribute.Int("event.id", id)))
+	defer span.End()
+
+	current, err := s.authorize(ctx, id, models.OrganizerOwner)
+	if err != nil {
+		return err
+	}
+	if version != 0 && version != current.Version {
+		s.log(ctx).Info(
+			"Event version mismatch",
+			slog.Int("id", id),
+			slog.Int("version", version),
+			slog.Int("current_version", current.Version),
+		)
+		return service.ErrVersionMismatch
+	}
+
+	deleted := *current
+	err = s.eventRepo.DeleteById(ctx, id, version, entry(ctx, &models.EventHistory{
+		Action:   models.ActionDelete,
+		Snapshot: &deleted,
+	}))
 	if err != nil {
+		if errors.Is(err, repositories.ErrVersionMismatch) {
+			s.log(ctx).Info(
+				"Event version mismatch",
+				slog.Int("id", id),
+				slog.Int("version", version),
+			)
+			return service.ErrVersionMismatch
+		}
 		if errors.Is(err, repositories.ErrRecordNotFound) {
-			s.logger.Warn(
+			s.log(ctx).Warn(
 				"Event not found",
 				slog.Int("id", id),
 			)
 			return service.ErrRecordNotFound
 		}
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error delete event",
 			slog.Int("id", id),
 			slog.String("err", err.Error()),
 		)
 		return service.ErrRepositoryError
 	}
-	err = s.cache.Del(ctx, "event:"+strconv.Itoa(id))
+	s.invalidate(ctx, id)
+	s.log(ctx).Info(
+		"Successful delete event",
+		slog.Int("id", id),
+	)
+	return nil
+}
+
+// Restore brings back an event deleted within the restore period.
+func (s *EventService) Restore(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "EventService.Restore", trace.WithAttributes(attribute.Int("event.id", id)))
+	defer span.End()
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		s.log(ctx).Warn(
+			"Unauthenticated access to event",
+			slog.Int("id", id),
+		)
+		return service.ErrUnauthenticated
+	}
+
+	event, err := s.eventRepo.GetDeletedById(ctx, id)
 	if err != nil {
-		s.logger.Error(
-			"Error in redis delete event",
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Deleted event not found",
+				slog.Int("id", id),
+			)
+			return service.ErrRecordNotFound
+		}
+		s.log(ctx).Error(
+			"Error getting deleted event",
 			slog.Int("id", id),
 			slog.String("err", err.Error()),
 		)
+		return service.ErrRepositoryError
 	}
-	s.logger.Info(
-		"Successful delete event",
+
+	if !canManage(caller, event) {
+		s.log(ctx).Warn(
+			"Permission denied",
+			slog.Int("id", id),
+			slog.String("user_id", caller.UserId),
+		)
+		return service.ErrPermissionDenied
+	}
+
+	if time.Since(*event.DeletedAt) > s.config.Retention.RestorePeriod {
+		s.log(ctx).Info(
+			"Restore period expired",
+			slog.Int("id", id),
+			slog.Time("deleted_at", *event.DeletedAt),
+		)
+		return service.ErrRestoreExpired
+	}
+
+	restored := *event
+	restored.DeletedAt = nil
+	_, err = s.eventRepo.Restore(ctx, id, entry(ctx, &models.EventHistory{
+		Action:   models.ActionRestore,
+		Snapshot: &restored,
+	}))
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Deleted event not found",
+				slog.Int("id", id),
+			)
+			return service.ErrRecordNotFound
+		}
+		s.log(ctx).Error(
+			"Error restore event",
+			slog.Int("id", id),
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+
+	s.invalidate(ctx, id)
+	s.log(ctx).Info(
+		"Successful restore event",
 		slog.Int("id", id),
 	)
 	return nil
 }
 
 func (s *EventService) Update(ctx context.Context, event *models.EventUpdateRequest) error {
-	err := s.eventRepo.Update(ctx, event)
+	ctx, span := tracer.Start(ctx, "EventService.Update", trace.WithAttributes(attribute.Int("event.id", event.Id)))
+	defer span.End()
+
+	current, err := s.authorize(ctx, event.Id, models.OrganizerEditor)
 	if err != nil {
+		return err
+	}
+
+	if event.Version != 0 && event.Version != current.Version {
+		s.log(ctx).Info(
+			"Event version mismatch",
+			slog.Int("id", event.Id),
+			slog.Int("version", event.Version),
+			slog.Int("current_version", current.Version),
+		)
+		return service.ErrVersionMismatch
+	}
+
+	if err := s.validation.update(event, current); err != nil {
+		s.log(ctx).Info(
+			"Invalid event update",
+			slog.Int("id", event.Id),
+			slog.String("err", err.Error()),
+		)
+		return err
+	}
+
+	updated, changes := applyUpdate(current, event)
+	action := models.ActionUpdate
+	if _, ok := changes[models.FieldStatus]; ok {
+		action = models.ActionStatusChange
+	}
+	_, err = s.eventRepo.Update(ctx, event, entry(ctx, &models.EventHistory{
+		Action:   action,
+		Changes:  changes,
+		Snapshot: updated,
+	}))
+	if err != nil {
+		if errors.Is(err, repositories.ErrVersionMismatch) {
+			s.log(ctx).Info(
+				"Event version mismatch",
+				slog.Int("id", event.Id),
+				slog.Int("version", event.Version),
+			)
+			return service.ErrVersionMismatch
+		}
 		if errors.Is(err, repositories.ErrRecordNotFound) {
-			s.logger.Warn(
+			s.log(ctx).Warn(
 				"Event not found",
 				slog.Int("id", event.Id),
 			)
 			return service.ErrRecordNotFound
 		}
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error update event",
 			slog.Int("id", event.Id),
 			slog.String("err", err.Error()),
 		)
 		return service.ErrRepositoryError
 	}
-	err = s.cache.Del(ctx, "event:"+strconv.Itoa(event.Id))
-	if err != nil {
-		s.logger.Error(
-			"Error in redis delete event",
-			slog.Int("id", event.Id),
-			slog.String("err", err.Error()),
-		)
-	}
-	s.logger.Info(
+	s.invalidate(ctx, event.Id)
+	s.log(ctx).Info(
 		"Successful update event",
 		slog.Int("id", event.Id),
 	)
 	return nil
 }
 
-func (s *EventService) GetAllByCreator(ctx context.Context, creator string) ([]*models.EventResponse, error) {
-	events, err := s.eventRepo.GetAllByCreator(ctx, creator)
+// GetAllByCreator returns the events of the creator visible to the caller. With
+// organized it also returns the events the creator co-organizes.
+func (s *EventService) GetAllByCreator(ctx context.Context, creator string, organized bool) ([]*models.EventResponse, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetAllByCreator", trace.WithAttributes(attribute.String("event.creator", creator)))
+	defer span.End()
+
+	events, err := s.eventRepo.GetAllByCreator(ctx, creator, organized, viewer(ctx))
 	if err != nil {
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error getting all events by creator",
 			slog.String("creator", creator),
 			slog.String("err", err.Error()),
 		)
 		return nil, service.ErrRepositoryError
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful getting all events by creator",
 		slog.String("creator", creator),
 	)
 	return events, nil
 }
 
+// GetAllByStatus returns the events with the status visible to the caller.
 func (s *EventService) GetAllByStatus(ctx context.Context, status string) ([]*models.EventResponse, error) {
-	events, err := s.eventRepo.GetAllByStatus(ctx, status)
+	ctx, span := tracer.Start(ctx, "EventService.GetAllByStatus", trace.WithAttributes(attribute.String("event.status", status)))
+	defer span.End()
+
+	events, err := s.eventRepo.GetAllByStatus(ctx, status, viewer(ctx))
 	if err != nil {
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error getting all events by status",
 			slog.String("status", status),
 			slog.String("err", err.Error()),
 		)
 		return nil, service.ErrRepositoryError
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful getting all events",
 		slog.String("status", status),
 	)
 	return events, nil
 }
 
-func (s *EventService) Register(ctx context.Context, user_id string, event_id int) error {
-	ok, err := s.eventUserRepo.Exists(ctx, user_id, event_id)
-	if err != nil {
-		s.logger.Error(
+// Register registers the user and the guests for the event with the ticket type,
+// which must be zero when the event has no ticket types. Each guest takes a seat.
+// The answers must fill in the registration form of the event, and the invite code
+// must be valid for the user when the event is private.
+// On an event that requires approval the registration stays pending and takes no
+// seat until the creator approves it.
+func (s *EventService) Register(ctx context.Context, user_id string, event_id int, ticket_type_id int, guests int, answers []models.Answer, code string) error {
+	ctx, span := tracer.Start(ctx, "EventService.Register", trace.WithAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	registration, err := s.eventUserRepo.Get(ctx, user_id, event_id)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
 			"Error registered user in event",
 			slog.String("err", err.Error()),
 		)
 		return service.ErrRepositoryError
-	} else if ok {
-		s.logger.Info(
-			"User is already registered",
-		)
-		return service.ErrRegistered
 	}
-
-	err = s.eventRepo.IncreaseCurrentAttedance(ctx, event_id)
-	if err != nil {
-		if errors.Is(err, repositories.ErrMaxRegistered) {
-			s.logger.Info(
-				"Maximum number of users",
+	if registration != nil {
+		switch registration.Status {
+		case models.RegistrationActive:
+			s.log(ctx).Info(
+				"User is already registered",
 			)
-			return service.ErrMaxRegistered
-		} else if errors.Is(err, repositories.ErrRecordNotFound) {
-			s.logger.Info(
-				"Event not found",
+			return service.ErrRegistered
+		case models.RegistrationPending:
+			s.log(ctx).Info(
+				"User registration is waiting for approval",
 			)
-			return service.ErrRecordNotFound
+			return service.ErrPending
+		case models.RegistrationRejected:
+			s.log(ctx).Info(
+				"User registration has been rejected",
+			)
+			return service.ErrRejected
 		}
-		s.logger.Error(
-			"Error registered user in event",
+	}
+
+	event, err := s.get(ctx, event_id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkInvite(ctx, event, user_id, code); err != nil {
+		return err
+	}
+	if err := s.validation.register(event); err != nil {
+		s.log(ctx).Info(
+			"Registration is not open",
+			slog.Int("event_id", event_id),
 			slog.String("err", err.Error()),
 		)
 		return err
 	}
+	if err := s.validation.guests(event, guests); err != nil {
+		s.log(ctx).Info(
+			"Invalid number of guests",
+			slog.Int("event_id", event_id),
+			slog.Int("guests", guests),
+		)
+		return err
+	}
+	if err := s.checkTicketType(ctx, event_id, ticket_type_id); err != nil {
+		return err
+	}
+	if err := s.checkAnswers(ctx, event_id, answers); err != nil {
+		return err
+	}
+
+	registration = &models.Registration{
+		EventId:      event_id,
+		UserId:       user_id,
+		Status:       models.RegistrationActive,
+		TicketTypeId: ticket_type_id,
+		Guests:       guests,
+		Answers:      answers,
+	}
+	if event.RequiresApproval {
+		registration.Status = models.RegistrationPending
+	}
+	status := registration.Status
 
-	err = s.eventUserRepo.Create(ctx, user_id, event_id)
+	err = s.eventUserRepo.Register(ctx, registration)
 	if err != nil {
-		s.logger.Error(
-			"Registered user error in event",
-			slog.String("err", err.Error()),
-		)
-		return service.ErrRepositoryError
+		return s.seatError(ctx, ticket_type_id, err)
+	}
+	if status == models.RegistrationActive {
+		s.invalidate(ctx, event_id)
+		metrics.Registered()
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful registered user in event",
 		slog.String("user_id", user_id),
 		slog.Int("event_id", event_id),
+		slog.String("status", status),
 	)
 	return nil
 }
 
+// CancellRegister cancels an active or pending registration, only an active one frees its
+// seats, which the repository gives back together with the cancellation.
+// An active registration cannot be cancelled after the cancellation cut-off of the event.
 func (s *EventService) CancellRegister(ctx context.Context, user_id string, event_id int) error {
-	ok, err := s.eventUserRepo.Exists(ctx, user_id, event_id)
-	if err != nil {
-		s.logger.Error(
+	ctx, span := tracer.Start(ctx, "EventService.CancellRegister", trace.WithAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	registration, err := s.eventUserRepo.Get(ctx, user_id, event_id)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
 			"Error unregistering user from event",
 			slog.String("err", err.Error()),
 		)
 		return service.ErrRepositoryError
-	} else if !ok {
-		s.logger.Info(
+	}
+	if registration == nil || (registration.Status != models.RegistrationActive && registration.Status != models.RegistrationPending) {
+		s.log(ctx).Info(
 			"User is not registered",
 		)
 		return service.ErrNotRegistered
 	}
 
-	err = s.eventRepo.DecreaseCurrentAttedance(ctx, event_id)
-	if err != nil {
-		if errors.Is(err, repositories.ErrRecordNotFound) {
-			s.logger.Info(
-				"Event not found",
+	if registration.Status == models.RegistrationActive {
+		event, err := s.get(ctx, event_id)
+		if err != nil {
+			return err
+		}
+		if err := s.validation.cancel(event); err != nil {
+			s.log(ctx).Info(
+				"Cancellation is closed",
+				slog.Int("event_id", event_id),
+				slog.String("err", err.Error()),
 			)
-			return service.ErrRecordNotFound
+			return err
 		}
-		s.logger.Error(
-			"Error unregistering user from event",
-			slog.String("err", err.Error()),
-		)
-		return service.ErrRepositoryError
 	}
-	err = s.eventUserRepo.Delete(ctx, user_id, event_id)
+	err = s.eventUserRepo.Cancel(ctx, user_id, event_id)
 	if err != nil {
 		if errors.Is(err, repositories.ErrRecordNotFound) {
-			s.logger.Info(
+			s.log(ctx).Info(
 				"Event not found",
 			)
 			return service.ErrRecordNotFound
 		}
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error unregistering user from event",
 			slog.String("err", err.Error()),
 		)
 		return service.ErrRepositoryError
 	}
-	s.logger.Info(
+	if registration.Status == models.RegistrationActive {
+		s.invalidate(ctx, event_id)
+		metrics.Cancelled()
+	}
+	s.log(ctx).Info(
 		"Successful unregistered user in event",
 		slog.String("user_id", user_id),
 		slog.Int("event_id", event_id),
@@ -310,36 +628,135 @@ func (s *EventService) CancellRegister(ctx context.Context, user_id string, even
 	return nil
 }
 
-func (s *EventService) GetAllByUser(ctx context.Context, user_id string) ([]*models.EventResponse, error) {
-	events, err := s.eventRepo.GetAllByUser(ctx, user_id)
+// GetAllByUser returns the events the user has registered for that are visible to
+// the caller with the registration metadata, cancelled registrations included.
+func (s *EventService) GetAllByUser(ctx context.Context, user_id string) ([]*models.EventRegistration, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetAllByUser", trace.WithAttributes(attribute.String("user.id", user_id)))
+	defer span.End()
+
+	events, err := s.eventRepo.GetAllByUser(ctx, user_id, viewer(ctx))
 	if err != nil {
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error getting all events by user",
 			slog.String("user", user_id),
 			slog.String("err", err.Error()),
 		)
 		return nil, service.ErrRepositoryError
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful getting all events by user",
 		slog.String("user", user_id),
 	)
 	return events, nil
 }
 
-func (s *EventService) GetAllUsersByEvent(ctx context.Context, event_id int) (*[]string, error) {
-	users_id, err := s.eventUserRepo.GetAllByEvent(ctx, event_id)
+// GetAllUsersByEvent returns the active and cancelled registrations of the event.
+func (s *EventService) GetAllUsersByEvent(ctx context.Context, event_id int) ([]*models.Registration, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetAllUsersByEvent", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	registrations, err := s.eventUserRepo.GetAllByEvent(ctx, event_id)
 	if err != nil {
-		s.logger.Error(
+		s.log(ctx).Error(
 			"Error getting all users by event",
 			slog.Int("event", event_id),
 			slog.String("err", err.Error()),
 		)
 		return nil, service.ErrRepositoryError
 	}
-	s.logger.Info(
+	s.log(ctx).Info(
 		"Successful getting all users by event",
 		slog.Int("event", event_id),
 	)
-	return users_id, nil
+	return registrations, nil
+}
+
+// seatError returns the service error for a registration that could not be stored
+// with its seats.
+func (s *EventService) seatError(ctx context.Context, ticket_type_id int, err error) error {
+	if errors.Is(err, repositories.ErrMaxRegistered) {
+		s.log(ctx).Info(
+			"Maximum number of users",
+		)
+		return service.ErrMaxRegistered
+	} else if errors.Is(err, repositories.ErrSoldOut) {
+		s.log(ctx).Info(
+			"Ticket type is sold out",
+			slog.Int("ticket_type_id", ticket_type_id),
+		)
+		return service.ErrSoldOut
+	} else if errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Info(
+			"Event not found",
+		)
+		return service.ErrRecordNotFound
+	}
+	s.log(ctx).Error(
+		"Registered user error in event",
+		slog.String("err", err.Error()),
+	)
+	return service.ErrRepositoryError
+}
+
+// invalidate removes the cached event after a write that changes it. Registrations
+// change the attendance and the version too, so GetById would otherwise return a
+// stale ETag.
+func (s *EventService) invalidate(ctx context.Context, id int) {
+	err := s.cache.Del(ctx, "event:"+strconv.Itoa(id))
+	if err != nil {
+		s.log(ctx).Error(
+			"Error in redis delete event",
+			slog.Int("id", id),
+			slog.String("err", err.Error()),
+		)
+	}
+}
+
+// authorize checks that the caller from ctx is an admin or an organizer of the event
+// with at least the given role and returns the stored event.
+func (s *EventService) authorize(ctx context.Context, id int, role string) (*models.EventResponse, error) {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		s.log(ctx).Warn(
+			"Unauthenticated access to event",
+			slog.Int("id", id),
+		)
+		return nil, service.ErrUnauthenticated
+	}
+
+	event, err := s.eventRepo.GetById(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Warn(
+				"Event not found",
+				slog.Int("id", id),
+			)
+			return nil, service.ErrRecordNotFound
+		}
+		s.log(ctx).Error(
+			"Error getting event",
+			slog.Int("id", id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+
+	granted, err := s.role(ctx, caller, event)
+	if err != nil {
+		return nil, err
+	}
+	if !permits(granted, role) {
+		s.log(ctx).Warn(
+			"Permission denied",
+			slog.Int("id", id),
+			slog.String("user_id", caller.UserId),
+			slog.String("role", granted),
+		)
+		return nil, service.ErrPermissionDenied
+	}
+	return event, nil
 }
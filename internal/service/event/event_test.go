@@ -1,10 +1,15 @@
 package event
 
 import (
+	"bytes"
 	"context"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/cache"
 	"github.com/Estriper0/EventService/internal/cache/mocks"
 	"github.com/Estriper0/EventService/internal/config"
 	"github.com/Estriper0/EventService/internal/logger"
@@ -12,8 +17,14 @@ import (
 	"github.com/Estriper0/EventService/internal/repositories"
 	mocksRepo "github.com/Estriper0/EventService/internal/repositories/mocks"
 	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/ticket"
+	"github.com/Estriper0/EventService/internal/tracing"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestEventService_GetAll(t *testing.T) {
@@ -22,11 +33,16 @@ func TestEventService_GetAll(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
 	ctx := context.Background()
 
@@ -40,7 +56,7 @@ func TestEventService_GetAll(t *testing.T) {
 			name: "success",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAll(ctx).
+					GetAll(gomock.Any(), gomock.Any()).
 					Return([]*models.EventResponse{
 						{Id: 1, Title: "Event 1"},
 					}, nil)
@@ -52,7 +68,7 @@ func TestEventService_GetAll(t *testing.T) {
 			name: "repository error",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAll(ctx).
+					GetAll(gomock.Any(), gomock.Any()).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
@@ -80,17 +96,25 @@ func TestEventService_Create(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
 	ctx := context.Background()
-	req := &models.EventCreateRequest{Title: "New Event"}
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	req := &models.EventCreateRequest{Title: "New Event", StartDate: time.Now().Add(time.Hour), Location: "Zoom", Creator: creator}
 
 	tests := []struct {
 		name    string
+		ctx     context.Context
+		req     *models.EventCreateRequest
 		setup   func()
 		wantID  int
 		wantErr error
@@ -99,8 +123,13 @@ func TestEventService_Create(t *testing.T) {
 			name: "success",
 			setup: func() {
 				mockRepo.EXPECT().
-					Create(ctx, req).
-					Return(42, nil)
+					Create(gomock.Any(), req, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *models.EventCreateRequest, history *models.EventHistory) (int, error) {
+						assert.Equal(t, models.ActionCreate, history.Action)
+						assert.Equal(t, req.Title, history.Changes[models.FieldTitle].New)
+						assert.Equal(t, req.Title, history.Snapshot.Title)
+						return 42, nil
+					})
 			},
 			wantID:  42,
 			wantErr: nil,
@@ -109,12 +138,35 @@ func TestEventService_Create(t *testing.T) {
 			name: "repository error",
 			setup: func() {
 				mockRepo.EXPECT().
-					Create(ctx, req).
+					Create(gomock.Any(), req, gomock.Any()).
 					Return(0, assert.AnError)
 			},
 			wantID:  0,
 			wantErr: service.ErrRepositoryError,
 		},
+		{
+			name:    "start date in the past",
+			req:     &models.EventCreateRequest{Title: "New Event", StartDate: time.Now().Add(-time.Hour), Location: "Zoom"},
+			wantID:  0,
+			wantErr: service.ErrStartDateInPast,
+		},
+		{
+			name: "caller creates their own event",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: strings.ToUpper(creator)}),
+			setup: func() {
+				mockRepo.EXPECT().
+					Create(gomock.Any(), req, gomock.Any()).
+					Return(43, nil)
+			},
+			wantID:  43,
+			wantErr: nil,
+		},
+		{
+			name:    "caller creates an event of another user",
+			ctx:     auth.WithCaller(context.Background(), &auth.Caller{UserId: "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"}),
+			wantID:  0,
+			wantErr: service.ErrPermissionDenied,
+		},
 	}
 
 	for _, tt := range tests {
@@ -122,8 +174,14 @@ func TestEventService_Create(t *testing.T) {
 			if tt.setup != nil {
 				tt.setup()
 			}
+			if tt.req == nil {
+				tt.req = req
+			}
+			if tt.ctx == nil {
+				tt.ctx = ctx
+			}
 
-			gotID, err := eventService.Create(ctx, req)
+			gotID, err := eventService.Create(tt.ctx, tt.req)
 
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.wantID, gotID)
@@ -137,11 +195,16 @@ func TestEventService_GetById(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Minute}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
 	ctx := context.Background()
 	event := &models.EventResponse{Id: 1, Title: "Event"}
@@ -158,7 +221,7 @@ func TestEventService_GetById(t *testing.T) {
 			id:   1,
 			setup: func() {
 				mockCache.EXPECT().
-					GetEvent(ctx, 1).
+					GetEvent(gomock.Any(), 1).
 					Return(event, nil)
 			},
 			want:    event,
@@ -169,15 +232,15 @@ func TestEventService_GetById(t *testing.T) {
 			id:   2,
 			setup: func() {
 				mockCache.EXPECT().
-					GetEvent(ctx, 2).
+					GetEvent(gomock.Any(), 2).
 					Return(nil, assert.AnError)
 
 				mockRepo.EXPECT().
-					GetById(ctx, 2).
+					GetById(gomock.Any(), 2).
 					Return(&models.EventResponse{Id: 2, Title: "DB Event"}, nil)
 
 				mockCache.EXPECT().
-					SetEvent(ctx, gomock.Any(), cfg.Redis.CacheTTL).
+					SetEvent(gomock.Any(), gomock.Any(), cfg.Redis.CacheTTL).
 					Return(nil)
 			},
 			want:    &models.EventResponse{Id: 2, Title: "DB Event"},
@@ -188,11 +251,11 @@ func TestEventService_GetById(t *testing.T) {
 			id:   3,
 			setup: func() {
 				mockCache.EXPECT().
-					GetEvent(ctx, 3).
+					GetEvent(gomock.Any(), 3).
 					Return(nil, assert.AnError)
 
 				mockRepo.EXPECT().
-					GetById(ctx, 3).
+					GetById(gomock.Any(), 3).
 					Return(nil, repositories.ErrRecordNotFound)
 			},
 			want:    nil,
@@ -203,11 +266,11 @@ func TestEventService_GetById(t *testing.T) {
 			id:   4,
 			setup: func() {
 				mockCache.EXPECT().
-					GetEvent(ctx, 4).
+					GetEvent(gomock.Any(), 4).
 					Return(nil, assert.AnError)
 
 				mockRepo.EXPECT().
-					GetById(ctx, 4).
+					GetById(gomock.Any(), 4).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
@@ -235,51 +298,136 @@ func TestEventService_DeleteById(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
-	ctx := context.Background()
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator, Version: 3}
 
 	tests := []struct {
 		name    string
+		ctx     context.Context
 		id      int
+		version int
 		setup   func()
 		wantErr error
 	}{
 		{
 			name: "success",
+			ctx:  ctx,
 			id:   1,
 			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
 				mockRepo.EXPECT().
-					DeleteById(ctx, 1).
-					Return(nil)
-				mockCache.EXPECT().Del(ctx, gomock.Any()).Return(assert.AnError)
+					DeleteById(gomock.Any(), 1, 0, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, _ int, history *models.EventHistory) error {
+						assert.Equal(t, models.ActionDelete, history.Action)
+						assert.Equal(t, creator, history.Actor)
+						return nil
+					})
+				mockCache.EXPECT().Del(gomock.Any(), gomock.Any()).Return(assert.AnError)
 			},
 			wantErr: nil,
 		},
 		{
 			name: "not found",
+			ctx:  ctx,
 			id:   2,
 			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 2).Return(event, nil)
 				mockRepo.EXPECT().
-					DeleteById(ctx, 2).
+					DeleteById(gomock.Any(), 2, 0, gomock.Any()).
 					Return(repositories.ErrRecordNotFound)
 			},
 			wantErr: service.ErrRecordNotFound,
 		},
 		{
 			name: "repository error",
+			ctx:  ctx,
 			id:   3,
 			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 3).Return(event, nil)
 				mockRepo.EXPECT().
-					DeleteById(ctx, 3).
+					DeleteById(gomock.Any(), 3, 0, gomock.Any()).
 					Return(assert.AnError)
 			},
 			wantErr: service.ErrRepositoryError,
 		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			id:      4,
+			wantErr: service.ErrUnauthenticated,
+		},
+		{
+			name: "not the creator",
+			ctx:  ctx,
+			id:   5,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 5).
+					Return(&models.EventResponse{Id: 5, Creator: "ea28ecf4-02b1-453d-965d-408253a874b9"}, nil)
+			},
+			wantErr: service.ErrPermissionDenied,
+		},
+		{
+			name: "not found on authorize",
+			ctx:  ctx,
+			id:   6,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 6).
+					Return(nil, repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name:    "matching version",
+			ctx:     ctx,
+			id:      7,
+			version: 3,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 7).Return(event, nil)
+				mockRepo.EXPECT().
+					DeleteById(gomock.Any(), 7, 3, gomock.Any()).
+					Return(nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:7").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "stale version",
+			ctx:     ctx,
+			id:      8,
+			version: 2,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 8).Return(event, nil)
+			},
+			wantErr: service.ErrVersionMismatch,
+		},
+		{
+			name:    "modified concurrently",
+			ctx:     ctx,
+			id:      9,
+			version: 3,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 9).Return(event, nil)
+				mockRepo.EXPECT().
+					DeleteById(gomock.Any(), 9, 3, gomock.Any()).
+					Return(repositories.ErrVersionMismatch)
+			},
+			wantErr: service.ErrVersionMismatch,
+		},
 	}
 
 	for _, tt := range tests {
@@ -288,7 +436,7 @@ func TestEventService_DeleteById(t *testing.T) {
 				tt.setup()
 			}
 
-			err := eventService.DeleteById(ctx, tt.id)
+			err := eventService.DeleteById(tt.ctx, tt.id, tt.version)
 
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
@@ -301,52 +449,157 @@ func TestEventService_Update(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
-	ctx := context.Background()
-	req := &models.EventUpdateRequest{Id: 1, Title: "Updated"}
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	adminCtx := auth.WithCaller(context.Background(), &auth.Caller{
+		UserId: "ea28ecf4-02b1-453d-965d-408253a874b9",
+		Role:   auth.RoleAdmin,
+	})
+	req := &models.EventUpdateRequest{Id: 1, Title: "Updated", Location: "Zoom"}
 
 	tests := []struct {
 		name    string
+		ctx     context.Context
 		req     *models.EventUpdateRequest
 		setup   func()
 		wantErr error
 	}{
 		{
 			name: "success",
+			ctx:  ctx,
 			req:  req,
 			setup: func() {
 				mockRepo.EXPECT().
-					Update(ctx, req).
-					Return(nil)
-				mockCache.EXPECT().Del(ctx, gomock.Any()).Return(assert.AnError)
+					GetById(gomock.Any(), 1).
+					Return(&models.EventResponse{Id: 1, Creator: creator}, nil)
+				mockRepo.EXPECT().
+					Update(gomock.Any(), req, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *models.EventUpdateRequest, history *models.EventHistory) (int, error) {
+						assert.Equal(t, models.ActionUpdate, history.Action)
+						assert.Equal(t, models.FieldChange{Old: "", New: "Updated"}, history.Changes[models.FieldTitle])
+						assert.Equal(t, "Updated", history.Snapshot.Title)
+						return 2, nil
+					})
+				mockCache.EXPECT().Del(gomock.Any(), gomock.Any()).Return(assert.AnError)
 			},
 			wantErr: nil,
 		},
 		{
 			name: "not found",
-			req:  &models.EventUpdateRequest{Id: 2},
+			ctx:  ctx,
+			req:  &models.EventUpdateRequest{Id: 2, Location: "Zoom"},
 			setup: func() {
 				mockRepo.EXPECT().
-					Update(ctx, gomock.Any()).
-					Return(repositories.ErrRecordNotFound)
+					GetById(gomock.Any(), 2).
+					Return(nil, repositories.ErrRecordNotFound)
 			},
 			wantErr: service.ErrRecordNotFound,
 		},
 		{
 			name: "repository error",
-			req:  &models.EventUpdateRequest{Id: 3},
+			ctx:  ctx,
+			req:  &models.EventUpdateRequest{Id: 3, Location: "Zoom"},
 			setup: func() {
 				mockRepo.EXPECT().
-					Update(ctx, gomock.Any()).
-					Return(assert.AnError)
+					GetById(gomock.Any(), 3).
+					Return(&models.EventResponse{Id: 3, Creator: creator}, nil)
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(0, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name: "repository error on authorize",
+			ctx:  ctx,
+			req:  &models.EventUpdateRequest{Id: 4, Location: "Zoom"},
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 4).
+					Return(nil, assert.AnError)
 			},
 			wantErr: service.ErrRepositoryError,
 		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			req:     &models.EventUpdateRequest{Id: 5, Location: "Zoom"},
+			wantErr: service.ErrUnauthenticated,
+		},
+		{
+			name: "not the creator",
+			ctx:  ctx,
+			req:  &models.EventUpdateRequest{Id: 6, Location: "Zoom"},
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 6).
+					Return(&models.EventResponse{Id: 6, Creator: "ea29ecf4-02b1-453d-965d-408253a874b9"}, nil)
+			},
+			wantErr: service.ErrPermissionDenied,
+		},
+		{
+			name: "admin",
+			ctx:  adminCtx,
+			req:  &models.EventUpdateRequest{Id: 7, Location: "Zoom"},
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 7).
+					Return(&models.EventResponse{Id: 7, Creator: creator}, nil)
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(2, nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:7").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "max attendees below current attendance",
+			ctx:  ctx,
+			req:  &models.EventUpdateRequest{Id: 8, Location: "Zoom", MaxAttendees: 5},
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 8).
+					Return(&models.EventResponse{Id: 8, Creator: creator, MaxAttendees: 10, CurrentAttendance: 7}, nil)
+			},
+			wantErr: service.ErrCapacityBelowAttendance,
+		},
+		{
+			name: "stale version",
+			ctx:  ctx,
+			req:  &models.EventUpdateRequest{Id: 9, Location: "Zoom", Version: 1},
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 9).
+					Return(&models.EventResponse{Id: 9, Creator: creator, Version: 2}, nil)
+			},
+			wantErr: service.ErrVersionMismatch,
+		},
+		{
+			name: "modified concurrently",
+			ctx:  ctx,
+			req:  &models.EventUpdateRequest{Id: 10, Location: "Zoom", Version: 2},
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 10).
+					Return(&models.EventResponse{Id: 10, Creator: creator, Version: 2}, nil)
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(0, repositories.ErrVersionMismatch)
+			},
+			wantErr: service.ErrVersionMismatch,
+		},
 	}
 
 	for _, tt := range tests {
@@ -355,7 +608,7 @@ func TestEventService_Update(t *testing.T) {
 				tt.setup()
 			}
 
-			err := eventService.Update(ctx, tt.req)
+			err := eventService.Update(tt.ctx, tt.req)
 
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
@@ -368,11 +621,16 @@ func TestEventService_GetAllByCreator(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
 	ctx := context.Background()
 
@@ -388,7 +646,7 @@ func TestEventService_GetAllByCreator(t *testing.T) {
 			creator: "user1",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAllByCreator(ctx, "user1").
+					GetAllByCreator(gomock.Any(), "user1", false, gomock.Any()).
 					Return([]*models.EventResponse{{Id: 1, Creator: "user1"}}, nil)
 			},
 			want:    []*models.EventResponse{{Id: 1, Creator: "user1"}},
@@ -399,7 +657,7 @@ func TestEventService_GetAllByCreator(t *testing.T) {
 			creator: "user2",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAllByCreator(ctx, "user2").
+					GetAllByCreator(gomock.Any(), "user2", false, gomock.Any()).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
@@ -413,7 +671,7 @@ func TestEventService_GetAllByCreator(t *testing.T) {
 				tt.setup()
 			}
 
-			got, err := eventService.GetAllByCreator(ctx, tt.creator)
+			got, err := eventService.GetAllByCreator(ctx, tt.creator, false)
 
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
@@ -427,11 +685,16 @@ func TestEventService_GetAllByStatus(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
 	ctx := context.Background()
 
@@ -447,7 +710,7 @@ func TestEventService_GetAllByStatus(t *testing.T) {
 			status: "active",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAllByStatus(ctx, "active").
+					GetAllByStatus(gomock.Any(), "active", gomock.Any()).
 					Return([]*models.EventResponse{{Id: 1, Status: "active"}}, nil)
 			},
 			want:    []*models.EventResponse{{Id: 1, Status: "active"}},
@@ -458,7 +721,7 @@ func TestEventService_GetAllByStatus(t *testing.T) {
 			status: "inactive",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAllByStatus(ctx, "inactive").
+					GetAllByStatus(gomock.Any(), "inactive", gomock.Any()).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
@@ -486,20 +749,44 @@ func TestEventService_Register(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
 	ctx := context.Background()
 
+	startDate := time.Now().Add(24 * time.Hour)
+	open := func(id int) *models.EventResponse {
+		return &models.EventResponse{Id: id, StartDate: startDate}
+	}
+	moderated := func(id int) *models.EventResponse {
+		return &models.EventResponse{Id: id, StartDate: startDate, RequiresApproval: true}
+	}
+	opensAt := time.Now().Add(time.Hour)
+	closedAt := time.Now().Add(-time.Hour)
+
+	saleEnded := time.Now().Add(-time.Hour)
+
+	mockQuestionRepo.EXPECT().
+		GetAllByEvent(gomock.Any(), gomock.Any()).
+		Return([]*models.Question{}, nil).
+		AnyTimes()
+
 	tests := []struct {
-		name    string
-		userID  string
-		eventID int
-		setup   func()
-		wantErr error
+		name         string
+		userID       string
+		eventID      int
+		ticketTypeID int
+		guests       int
+		setup        func()
+		wantErr      error
 	}{
 		{
 			name:    "success",
@@ -507,231 +794,554 @@ func TestEventService_Register(t *testing.T) {
 			eventID: 1,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user1", 1).
-					Return(false, nil)
-				mockRepo.EXPECT().
-					IncreaseCurrentAttedance(ctx, 1).
+					Get(gomock.Any(), "user1", 1).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 1).
+					Return(open(1), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 1).
+					Return([]*models.TicketType{}, nil)
+				mockCache.EXPECT().
+					Del(gomock.Any(), "event:1").
 					Return(nil)
 				mockEURepo.EXPECT().
-					Create(ctx, "user1", 1).
+					Register(gomock.Any(), &models.Registration{EventId: 1, UserId: "user1", Status: models.RegistrationActive}).
 					Return(nil)
 			},
 			wantErr: nil,
 		},
 		{
-			name:    "already registered",
-			userID:  "user2",
-			eventID: 2,
+			name:    "success after cancellation",
+			userID:  "user1",
+			eventID: 1,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user2", 2).
-					Return(true, nil)
+					Get(gomock.Any(), "user1", 1).
+					Return(&models.Registration{Status: models.RegistrationCancelled}, nil)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 1).
+					Return(open(1), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 1).
+					Return([]*models.TicketType{}, nil)
+				mockCache.EXPECT().
+					Del(gomock.Any(), "event:1").
+					Return(nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 1, UserId: "user1", Status: models.RegistrationActive}).
+					Return(nil)
 			},
-			wantErr: service.ErrRegistered,
+			wantErr: nil,
 		},
 		{
-			name:    "max registered",
-			userID:  "user3",
-			eventID: 3,
+			name:    "with guests",
+			userID:  "user1",
+			eventID: 13,
+			guests:  2,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user3", 3).
-					Return(false, nil)
-				mockRepo.EXPECT().
-					IncreaseCurrentAttedance(ctx, 3).
-					Return(repositories.ErrMaxRegistered)
+					Get(gomock.Any(), "user1", 13).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 13).
+					Return(&models.EventResponse{Id: 13, StartDate: startDate, MaxGuests: 2}, nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 13).
+					Return([]*models.TicketType{}, nil)
+				mockCache.EXPECT().
+					Del(gomock.Any(), "event:13").
+					Return(nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 13, UserId: "user1", Status: models.RegistrationActive, Guests: 2}).
+					Return(nil)
 			},
-			wantErr: service.ErrMaxRegistered,
+			wantErr: nil,
 		},
 		{
-			name:    "event not found on increase",
-			userID:  "user4",
-			eventID: 4,
+			name:    "too many guests",
+			userID:  "user1",
+			eventID: 13,
+			guests:  3,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user4", 4).
-					Return(false, nil)
-				mockRepo.EXPECT().
-					IncreaseCurrentAttedance(ctx, 4).
-					Return(repositories.ErrRecordNotFound)
+					Get(gomock.Any(), "user1", 13).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 13).
+					Return(&models.EventResponse{Id: 13, StartDate: startDate, MaxGuests: 2}, nil)
 			},
-			wantErr: service.ErrRecordNotFound,
+			wantErr: service.ErrTooManyGuests,
 		},
 		{
-			name:    "repository error on exists",
-			userID:  "user5",
-			eventID: 5,
+			name:    "guests not allowed",
+			userID:  "user1",
+			eventID: 1,
+			guests:  1,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user5", 5).
-					Return(false, assert.AnError)
+					Get(gomock.Any(), "user1", 1).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 1).
+					Return(open(1), nil)
 			},
-			wantErr: service.ErrRepositoryError,
+			wantErr: service.ErrTooManyGuests,
 		},
 		{
-			name:    "repository error on increase",
-			userID:  "user6",
-			eventID: 6,
+			name:    "negative guests",
+			userID:  "user1",
+			eventID: 13,
+			guests:  -1,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user6", 6).
-					Return(false, nil)
-				mockRepo.EXPECT().
-					IncreaseCurrentAttedance(ctx, 6).
-					Return(assert.AnError)
+					Get(gomock.Any(), "user1", 13).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 13).
+					Return(&models.EventResponse{Id: 13, StartDate: startDate, MaxGuests: 2}, nil)
 			},
-			wantErr: assert.AnError,
+			wantErr: service.ErrGuestsNegative,
 		},
 		{
-			name:    "repository error on create",
-			userID:  "user7",
-			eventID: 7,
+			name:    "registration not open yet",
+			userID:  "user1",
+			eventID: 12,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user7", 7).
-					Return(false, nil)
-				mockRepo.EXPECT().
-					IncreaseCurrentAttedance(ctx, 7).
-					Return(nil)
+					Get(gomock.Any(), "user1", 12).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 12).
+					Return(&models.EventResponse{Id: 12, StartDate: startDate, RegistrationOpensAt: &opensAt}, nil)
+			},
+			wantErr: service.ErrRegistrationNotOpen,
+		},
+		{
+			name:    "registration closed",
+			userID:  "user1",
+			eventID: 12,
+			setup: func() {
 				mockEURepo.EXPECT().
-					Create(ctx, "user7", 7).
-					Return(assert.AnError)
+					Get(gomock.Any(), "user1", 12).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 12).
+					Return(&models.EventResponse{Id: 12, StartDate: startDate, RegistrationClosesAt: &closedAt}, nil)
 			},
-			wantErr: service.ErrRepositoryError,
+			wantErr: service.ErrRegistrationClosed,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if tt.setup != nil {
-				tt.setup()
-			}
-
-			err := eventService.Register(ctx, tt.userID, tt.eventID)
-
-			assert.ErrorIs(t, err, tt.wantErr)
-		})
-	}
-}
-
-func TestEventService_CancellRegister(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
-	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
-	mockCache := mocks.NewMockCache(ctrl)
-	logger := logger.GetLogger("test")
-	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
-
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
-
-	ctx := context.Background()
-
-	tests := []struct {
-		name    string
-		userID  string
-		eventID int
-		setup   func()
-		wantErr error
-	}{
 		{
-			name:    "success",
+			name:    "event already started",
 			userID:  "user1",
-			eventID: 1,
+			eventID: 12,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user1", 1).
-					Return(true, nil)
-				mockRepo.EXPECT().
-					DecreaseCurrentAttedance(ctx, 1).
-					Return(nil)
+					Get(gomock.Any(), "user1", 12).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 12).
+					Return(&models.EventResponse{Id: 12, StartDate: closedAt}, nil)
+			},
+			wantErr: service.ErrRegistrationClosed,
+		},
+		{
+			name:    "pending on moderated event",
+			userID:  "user1",
+			eventID: 8,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 8).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 8).
+					Return(moderated(8), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 8).
+					Return([]*models.TicketType{}, nil)
 				mockEURepo.EXPECT().
-					Delete(ctx, "user1", 1).
+					Register(gomock.Any(), &models.Registration{EventId: 8, UserId: "user1", Status: models.RegistrationPending}).
 					Return(nil)
 			},
 			wantErr: nil,
 		},
 		{
-			name:    "not registered",
+			name:    "already registered",
 			userID:  "user2",
 			eventID: 2,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user2", 2).
-					Return(false, nil)
+					Get(gomock.Any(), "user2", 2).
+					Return(&models.Registration{Status: models.RegistrationActive}, nil)
 			},
-			wantErr: service.ErrNotRegistered,
+			wantErr: service.ErrRegistered,
 		},
 		{
-			name:    "event not found on decrease",
-			userID:  "user3",
-			eventID: 3,
+			name:    "already pending",
+			userID:  "user2",
+			eventID: 8,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user3", 3).
-					Return(true, nil)
-				mockRepo.EXPECT().
-					DecreaseCurrentAttedance(ctx, 3).
-					Return(repositories.ErrRecordNotFound)
+					Get(gomock.Any(), "user2", 8).
+					Return(&models.Registration{Status: models.RegistrationPending}, nil)
 			},
-			wantErr: service.ErrRecordNotFound,
+			wantErr: service.ErrPending,
 		},
 		{
-			name:    "repository error on exists",
-			userID:  "user4",
-			eventID: 4,
+			name:    "rejected",
+			userID:  "user2",
+			eventID: 8,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user4", 4).
-					Return(false, assert.AnError)
+					Get(gomock.Any(), "user2", 8).
+					Return(&models.Registration{Status: models.RegistrationRejected}, nil)
 			},
-			wantErr: service.ErrRepositoryError,
+			wantErr: service.ErrRejected,
 		},
 		{
-			name:    "repository error on decrease",
-			userID:  "user5",
-			eventID: 5,
+			name:    "event not found",
+			userID:  "user3",
+			eventID: 9,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user5", 5).
-					Return(true, nil)
+					Get(gomock.Any(), "user3", 9).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 9).
+					Return(nil, cache.ErrNotFound)
 				mockRepo.EXPECT().
-					DecreaseCurrentAttedance(ctx, 5).
-					Return(assert.AnError)
+					GetById(gomock.Any(), 9).
+					Return(nil, repositories.ErrRecordNotFound)
 			},
-			wantErr: service.ErrRepositoryError,
+			wantErr: service.ErrRecordNotFound,
 		},
 		{
-			name:    "event not found on delete",
-			userID:  "user6",
-			eventID: 6,
+			name:    "max registered",
+			userID:  "user3",
+			eventID: 3,
 			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user6", 6).
-					Return(true, nil)
-				mockRepo.EXPECT().
-					DecreaseCurrentAttedance(ctx, 6).
-					Return(nil)
+					Get(gomock.Any(), "user3", 3).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 3).
+					Return(open(3), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 3).
+					Return([]*models.TicketType{}, nil)
 				mockEURepo.EXPECT().
-					Delete(ctx, "user6", 6).
-					Return(repositories.ErrRecordNotFound)
+					Register(gomock.Any(), &models.Registration{EventId: 3, UserId: "user3", Status: models.RegistrationActive}).
+					Return(repositories.ErrMaxRegistered)
 			},
-			wantErr: service.ErrRecordNotFound,
+			wantErr: service.ErrMaxRegistered,
 		},
 		{
-			name:    "repository error on delete",
-			userID:  "user7",
-			eventID: 7,
-			setup: func() {
+			name:    "event deleted on register",
+			userID:  "user4",
+			eventID: 4,
+			setup: func() {
 				mockEURepo.EXPECT().
-					Exists(ctx, "user7", 7).
-					Return(true, nil)
-				mockRepo.EXPECT().
-					DecreaseCurrentAttedance(ctx, 7).
+					Get(gomock.Any(), "user4", 4).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 4).
+					Return(open(4), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 4).
+					Return([]*models.TicketType{}, nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 4, UserId: "user4", Status: models.RegistrationActive}).
+					Return(repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name:    "repository error on get",
+			userID:  "user5",
+			eventID: 5,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user5", 5).
+					Return(nil, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:    "repository error on register",
+			userID:  "user7",
+			eventID: 7,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user7", 7).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 7).
+					Return(open(7), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 7).
+					Return([]*models.TicketType{}, nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 7, UserId: "user7", Status: models.RegistrationActive}).
+					Return(assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:         "with ticket type",
+			userID:       "user1",
+			eventID:      11,
+			ticketTypeID: 3,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 11).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 11).
+					Return(open(11), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetById(gomock.Any(), 3).
+					Return(&models.TicketType{Id: 3, EventId: 11, Capacity: 10}, nil)
+				mockCache.EXPECT().
+					Del(gomock.Any(), "event:11").
+					Return(nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 11, UserId: "user1", Status: models.RegistrationActive, TicketTypeId: 3}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "ticket type required",
+			userID:  "user1",
+			eventID: 11,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 11).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 11).
+					Return(open(11), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetAllByEvent(gomock.Any(), 11).
+					Return([]*models.TicketType{{Id: 3, EventId: 11}}, nil)
+			},
+			wantErr: service.ErrTicketTypeRequired,
+		},
+		{
+			name:         "ticket type of another event",
+			userID:       "user1",
+			eventID:      11,
+			ticketTypeID: 4,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 11).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 11).
+					Return(open(11), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetById(gomock.Any(), 4).
+					Return(&models.TicketType{Id: 4, EventId: 12}, nil)
+			},
+			wantErr: service.ErrTicketTypeNotFound,
+		},
+		{
+			name:         "sale closed",
+			userID:       "user1",
+			eventID:      11,
+			ticketTypeID: 3,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 11).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 11).
+					Return(open(11), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetById(gomock.Any(), 3).
+					Return(&models.TicketType{Id: 3, EventId: 11, SaleEndsAt: &saleEnded}, nil)
+			},
+			wantErr: service.ErrSaleClosed,
+		},
+		{
+			name:         "ticket type sold out",
+			userID:       "user1",
+			eventID:      11,
+			ticketTypeID: 3,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 11).
+					Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 11).
+					Return(open(11), nil)
+				mockTicketTypeRepo.EXPECT().
+					GetById(gomock.Any(), 3).
+					Return(&models.TicketType{Id: 3, EventId: 11, Capacity: 1, Sold: 1}, nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 11, UserId: "user1", Status: models.RegistrationActive, TicketTypeId: 3}).
+					Return(repositories.ErrSoldOut)
+			},
+			wantErr: service.ErrSoldOut,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := eventService.Register(ctx, tt.userID, tt.eventID, tt.ticketTypeID, tt.guests, nil, "")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_CancellRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	ctx := context.Background()
+
+	active := &models.Registration{Status: models.RegistrationActive}
+	upcoming := func(id int) *models.EventResponse {
+		return &models.EventResponse{Id: id, StartDate: time.Now().Add(24 * time.Hour)}
+	}
+	cutoff := 48 * time.Hour
+
+	tests := []struct {
+		name    string
+		userID  string
+		eventID int
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:    "success",
+			userID:  "user1",
+			eventID: 1,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 1).
+					Return(active, nil)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 1).
+					Return(upcoming(1), nil)
+				mockEURepo.EXPECT().
+					Cancel(gomock.Any(), "user1", 1).
+					Return(nil)
+				mockCache.EXPECT().
+					Del(gomock.Any(), "event:1").
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "pending registration frees no seat",
+			userID:  "user1",
+			eventID: 8,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 8).
+					Return(&models.Registration{Status: models.RegistrationPending}, nil)
+				mockEURepo.EXPECT().
+					Cancel(gomock.Any(), "user1", 8).
 					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "cancellation cut-off passed",
+			userID:  "user1",
+			eventID: 12,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user1", 12).
+					Return(active, nil)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 12).
+					Return(&models.EventResponse{Id: 12, StartDate: time.Now().Add(24 * time.Hour), CancellationCutoff: &cutoff}, nil)
+			},
+			wantErr: service.ErrCancellationClosed,
+		},
+		{
+			name:    "not registered",
+			userID:  "user2",
+			eventID: 2,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user2", 2).
+					Return(nil, repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name:    "already cancelled",
+			userID:  "user2",
+			eventID: 2,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user2", 2).
+					Return(&models.Registration{Status: models.RegistrationCancelled}, nil)
+			},
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name:    "repository error on get",
+			userID:  "user4",
+			eventID: 4,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user4", 4).
+					Return(nil, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:    "event not found on cancel",
+			userID:  "user6",
+			eventID: 6,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user6", 6).
+					Return(active, nil)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 6).
+					Return(upcoming(6), nil)
+				mockEURepo.EXPECT().
+					Cancel(gomock.Any(), "user6", 6).
+					Return(repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name:    "repository error on cancel",
+			userID:  "user7",
+			eventID: 7,
+			setup: func() {
+				mockEURepo.EXPECT().
+					Get(gomock.Any(), "user7", 7).
+					Return(active, nil)
+				mockCache.EXPECT().
+					GetEvent(gomock.Any(), 7).
+					Return(upcoming(7), nil)
 				mockEURepo.EXPECT().
-					Delete(ctx, "user7", 7).
+					Cancel(gomock.Any(), "user7", 7).
 					Return(assert.AnError)
 			},
 			wantErr: service.ErrRepositoryError,
@@ -757,19 +1367,34 @@ func TestEventService_GetAllByUser(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
 	ctx := context.Background()
+	registrations := []*models.EventRegistration{
+		{
+			Event:        &models.EventResponse{Id: 1, Title: "Event"},
+			Registration: &models.Registration{EventId: 1, UserId: "user1", Status: models.RegistrationActive},
+		},
+		{
+			Event:        &models.EventResponse{Id: 2, Title: "Cancelled"},
+			Registration: &models.Registration{EventId: 2, UserId: "user1", Status: models.RegistrationCancelled, Cancellations: 1},
+		},
+	}
 
 	tests := []struct {
 		name    string
 		userID  string
 		setup   func()
-		want    []*models.EventResponse
+		want    []*models.EventRegistration
 		wantErr error
 	}{
 		{
@@ -777,10 +1402,10 @@ func TestEventService_GetAllByUser(t *testing.T) {
 			userID: "user1",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAllByUser(ctx, "user1").
-					Return([]*models.EventResponse{{Id: 1, Title: "Event"}}, nil)
+					GetAllByUser(gomock.Any(), "user1", gomock.Any()).
+					Return(registrations, nil)
 			},
-			want:    []*models.EventResponse{{Id: 1, Title: "Event"}},
+			want:    registrations,
 			wantErr: nil,
 		},
 		{
@@ -788,7 +1413,7 @@ func TestEventService_GetAllByUser(t *testing.T) {
 			userID: "user2",
 			setup: func() {
 				mockRepo.EXPECT().
-					GetAllByUser(ctx, "user2").
+					GetAllByUser(gomock.Any(), "user2", gomock.Any()).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
@@ -816,43 +1441,79 @@ func TestEventService_GetAllUsersByEvent(t *testing.T) {
 
 	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
 	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
 	mockCache := mocks.NewMockCache(ctrl)
 	logger := logger.GetLogger("test")
 	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
 
-	eventService := New(mockRepo, mockEURepo, mockCache, logger, cfg)
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
 
-	ctx := context.Background()
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	registrations := []*models.Registration{
+		{EventId: 1, UserId: "id_1", Status: models.RegistrationActive},
+		{EventId: 1, UserId: "id_2", Status: models.RegistrationCancelled, Cancellations: 2},
+	}
 
 	tests := []struct {
 		name    string
+		ctx     context.Context
 		eventID int
 		setup   func()
-		want    *[]string
+		want    []*models.Registration
 		wantErr error
 	}{
 		{
 			name:    "success",
+			ctx:     ctx,
 			eventID: 1,
 			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
 				mockEURepo.EXPECT().
-					GetAllByEvent(ctx, 1).
-					Return(&[]string{"id_1", "id_2"}, nil)
+					GetAllByEvent(gomock.Any(), 1).
+					Return(registrations, nil)
 			},
-			want: &[]string{"id_1", "id_2"},
+			want:    registrations,
 			wantErr: nil,
 		},
 		{
 			name:    "repository error",
+			ctx:     ctx,
 			eventID: 2,
 			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 2).Return(event, nil)
 				mockEURepo.EXPECT().
-					GetAllByEvent(ctx, 2).
+					GetAllByEvent(gomock.Any(), 2).
 					Return(nil, assert.AnError)
 			},
 			want:    nil,
 			wantErr: service.ErrRepositoryError,
 		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			eventID: 3,
+			want:    nil,
+			wantErr: service.ErrUnauthenticated,
+		},
+		{
+			name:    "not the creator",
+			ctx:     ctx,
+			eventID: 4,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetById(gomock.Any(), 4).
+					Return(&models.EventResponse{Id: 4, Creator: "ea28ecf4-02b1-453d-965d-408253a874b9"}, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrPermissionDenied,
+		},
 	}
 
 	for _, tt := range tests {
@@ -861,10 +1522,2493 @@ func TestEventService_GetAllUsersByEvent(t *testing.T) {
 				tt.setup()
 			}
 
-			got, err := eventService.GetAllUsersByEvent(ctx, tt.eventID)
+			got, err := eventService.GetAllUsersByEvent(tt.ctx, tt.eventID)
 
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestEventService_Tracing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := tracing.NewProvider(
+		&config.Tracing{ServiceName: "test", SampleRatio: 1},
+		sdktrace.WithSyncer(exporter),
+	)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		// The package tracers keep delegating to the first global provider,
+		// so it is shut down to stop recording spans in later tests.
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(previous)
+	})
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "request")
+
+	mockCache.EXPECT().
+		GetEvent(gomock.Any(), 1).
+		Return(nil, assert.AnError)
+	mockRepo.EXPECT().
+		GetById(gomock.Any(), 1).
+		DoAndReturn(func(ctx context.Context, id int) (*models.EventResponse, error) {
+			assert.Equal(t, parent.SpanContext().TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+			return &models.EventResponse{Id: 1}, nil
+		})
+	mockCache.EXPECT().
+		SetEvent(gomock.Any(), gomock.Any(), cfg.Redis.CacheTTL).
+		Return(nil)
+
+	_, err := eventService.GetById(ctx, 1)
+	parent.End()
+
+	assert.NoError(t, err)
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "EventService.GetById", spans[0].Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, "request", spans[1].Name)
+}
+
+func TestEventService_RequestLogger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger.GetLogger("test"), cfg)
+
+	buf := &bytes.Buffer{}
+	requestLogger := slog.New(slog.NewJSONHandler(buf, nil)).With(slog.String("request_id", "req-1"))
+	ctx := logger.WithLogger(context.Background(), requestLogger)
+
+	mockRepo.EXPECT().
+		GetAll(gomock.Any(), gomock.Any()).
+		Return([]*models.EventResponse{}, nil)
+
+	_, err := eventService.GetAll(ctx)
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `"request_id":"req-1"`)
+	assert.Contains(t, buf.String(), "Successful getting all events")
+}
+
+func TestEventService_GetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	history := []*models.EventHistory{{Id: 1, EventId: 1, Revision: 1, Action: models.ActionCreate}}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    []*models.EventHistory
+		wantErr error
+	}{
+		{
+			name: "success",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockHistoryRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(history, nil)
+			},
+			want:    history,
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockHistoryRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(nil, assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			want:    nil,
+			wantErr: service.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.GetHistory(tt.ctx, 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_GetRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator, Title: "Current", Version: 3}
+	snapshot := &models.EventResponse{Id: 1, Creator: creator, Title: "Old", Version: 1}
+
+	tests := []struct {
+		name     string
+		revision int
+		setup    func()
+		want     *models.EventResponse
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			revision: 1,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockHistoryRepo.EXPECT().
+					GetRevision(gomock.Any(), 1, 1).
+					Return(&models.EventHistory{EventId: 1, Revision: 1, Snapshot: snapshot}, nil)
+			},
+			want:    snapshot,
+			wantErr: nil,
+		},
+		{
+			name:     "not found",
+			revision: 0,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockHistoryRepo.EXPECT().
+					GetRevision(gomock.Any(), 1, 0).
+					Return(nil, repositories.ErrRecordNotFound)
+			},
+			want:    nil,
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name:     "repository error",
+			revision: 2,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockHistoryRepo.EXPECT().
+					GetRevision(gomock.Any(), 1, 2).
+					Return(nil, assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.GetRevision(ctx, 1, tt.revision)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Retention: config.Retention{RestorePeriod: 24 * time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	recently := time.Now().Add(-time.Hour)
+	longAgo := time.Now().Add(-48 * time.Hour)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "success",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetDeletedById(gomock.Any(), 1).
+					Return(&models.EventResponse{Id: 1, Creator: creator, DeletedAt: &recently, Version: 2}, nil)
+				mockRepo.EXPECT().
+					Restore(gomock.Any(), 1, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, history *models.EventHistory) (int, error) {
+						assert.Equal(t, models.ActionRestore, history.Action)
+						assert.Nil(t, history.Snapshot.DeletedAt)
+						return 3, nil
+					})
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "not deleted",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetDeletedById(gomock.Any(), 1).
+					Return(nil, repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name: "restore period expired",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetDeletedById(gomock.Any(), 1).
+					Return(&models.EventResponse{Id: 1, Creator: creator, DeletedAt: &longAgo}, nil)
+			},
+			wantErr: service.ErrRestoreExpired,
+		},
+		{
+			name: "not the creator",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetDeletedById(gomock.Any(), 1).
+					Return(&models.EventResponse{Id: 1, Creator: "ea28ecf4-02b1-453d-965d-408253a874b9", DeletedAt: &recently}, nil)
+			},
+			wantErr: service.ErrPermissionDenied,
+		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			wantErr: service.ErrUnauthenticated,
+		},
+		{
+			name: "repository error",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetDeletedById(gomock.Any(), 1).
+					Return(&models.EventResponse{Id: 1, Creator: creator, DeletedAt: &recently}, nil)
+				mockRepo.EXPECT().Restore(gomock.Any(), 1, gomock.Any()).Return(0, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := eventService.Restore(tt.ctx, 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_GetPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator, RequiresApproval: true}
+	pending := &models.Registration{EventId: 1, UserId: "user2", Status: models.RegistrationPending}
+	registrations := []*models.Registration{
+		{EventId: 1, UserId: "user1", Status: models.RegistrationActive},
+		pending,
+		{EventId: 1, UserId: "user3", Status: models.RegistrationRejected},
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    []*models.Registration
+		wantErr error
+	}{
+		{
+			name: "success",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(registrations, nil)
+			},
+			want:    []*models.Registration{pending},
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			ctx:  ctx,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(nil, assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name: "permission denied",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"}),
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.GetPending(tt.ctx, 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_Approve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator, RequiresApproval: true}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Approve(gomock.Any(), "user1", 1).Return(nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "not pending",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Approve(gomock.Any(), "user1", 1).Return(repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrNotPending,
+		},
+		{
+			name: "event is full",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Approve(gomock.Any(), "user1", 1).Return(repositories.ErrMaxRegistered)
+			},
+			wantErr: service.ErrMaxRegistered,
+		},
+		{
+			name: "ticket type sold out",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Approve(gomock.Any(), "user1", 1).Return(repositories.ErrSoldOut)
+			},
+			wantErr: service.ErrSoldOut,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Approve(gomock.Any(), "user1", 1).Return(assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := eventService.Approve(ctx, "user1", 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_Reject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator, RequiresApproval: true}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Reject(gomock.Any(), "user1", 1).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "not pending",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Reject(gomock.Any(), "user1", 1).Return(repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrNotPending,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Reject(gomock.Any(), "user1", 1).Return(assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := eventService.Reject(ctx, "user1", 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_GetRegistration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	event := &models.EventResponse{Id: 1, Creator: creator, RequiresApproval: true}
+	registration := &models.Registration{EventId: 1, UserId: user, Status: models.RegistrationPending}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *models.Registration
+		wantErr error
+	}{
+		{
+			name: "own registration",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: user}),
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(registration, nil)
+			},
+			want:    registration,
+			wantErr: nil,
+		},
+		{
+			name: "creator",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: creator}),
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(registration, nil)
+			},
+			want:    registration,
+			wantErr: nil,
+		},
+		{
+			name: "another user",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: "user3"}),
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrPermissionDenied,
+		},
+		{
+			name: "not registered",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: user}),
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(nil, repositories.ErrRecordNotFound)
+			},
+			want:    nil,
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			want:    nil,
+			wantErr: service.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.GetRegistration(tt.ctx, user, 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_GetTicket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Tickets: config.Tickets{Secret: "secret", QRSize: 128}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+	disabled := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, &config.Config{})
+
+	user := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: user})
+
+	tests := []struct {
+		name    string
+		service *EventService
+		qr      bool
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:    "success",
+			service: eventService,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(&models.Registration{Status: models.RegistrationActive}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "success with qr",
+			service: eventService,
+			qr:      true,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(&models.Registration{Status: models.RegistrationActive}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "pending registration",
+			service: eventService,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(&models.Registration{Status: models.RegistrationPending}, nil)
+			},
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name:    "not registered",
+			service: eventService,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(nil, repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name:    "tickets disabled",
+			service: disabled,
+			wantErr: service.ErrTicketsDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := tt.service.GetTicket(ctx, user, 1, tt.qr)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+			event, userID, err := ticket.New("secret").Verify(got.Token)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, event)
+			assert.Equal(t, user, userID)
+			assert.Equal(t, tt.qr, len(got.QR) > 0)
+		})
+	}
+}
+
+func TestEventService_CheckIn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Tickets: config.Tickets{Secret: "secret"}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	token := ticket.New("secret").Issue(1, user)
+	checkedInAt := time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		token   string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:  "success",
+			token: token,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(&models.Registration{EventId: 1, UserId: user, Status: models.RegistrationActive}, nil)
+				mockEURepo.EXPECT().CheckIn(gomock.Any(), user, 1).Return(checkedInAt, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "forged token",
+			token:   ticket.New("another secret").Issue(1, user),
+			wantErr: service.ErrInvalidTicket,
+		},
+		{
+			name:  "already checked in",
+			token: token,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(&models.Registration{Status: models.RegistrationActive, CheckedInAt: &checkedInAt}, nil)
+			},
+			wantErr: service.ErrCheckedIn,
+		},
+		{
+			name:  "checked in concurrently",
+			token: token,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(&models.Registration{Status: models.RegistrationActive}, nil)
+				mockEURepo.EXPECT().CheckIn(gomock.Any(), user, 1).Return(time.Time{}, repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrCheckedIn,
+		},
+		{
+			name:  "cancelled registration",
+			token: token,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(&models.Registration{Status: models.RegistrationCancelled}, nil)
+			},
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name:  "permission denied",
+			token: ticket.New("secret").Issue(2, user),
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 2).Return(&models.EventResponse{Id: 2, Creator: user}, nil)
+			},
+			wantErr: service.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.CheckIn(ctx, tt.token)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, &checkedInAt, got.CheckedInAt)
+			}
+		})
+	}
+}
+
+func TestEventService_GetAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	attendance := &models.Attendance{EventId: 1, Registered: 10, CheckedIn: 4}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *models.Attendance
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().GetAttendance(gomock.Any(), 1).Return(attendance, nil)
+			},
+			want:    attendance,
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().GetAttendance(gomock.Any(), 1).Return(nil, assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.GetAttendance(ctx, 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_CreateTicketType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	vip := &models.TicketType{EventId: 1, Name: "VIP", Capacity: 10}
+
+	tests := []struct {
+		name       string
+		ticketType *models.TicketType
+		setup      func()
+		want       int
+		wantErr    error
+	}{
+		{
+			name:       "success",
+			ticketType: vip,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().Create(gomock.Any(), vip).Return(5, nil)
+			},
+			want:    5,
+			wantErr: nil,
+		},
+		{
+			name:       "duplicate name",
+			ticketType: vip,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().Create(gomock.Any(), vip).Return(0, repositories.ErrAlreadyExists)
+			},
+			want:    0,
+			wantErr: service.ErrTicketTypeExists,
+		},
+		{
+			name:       "invalid capacity",
+			ticketType: &models.TicketType{EventId: 1, Name: "VIP"},
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			},
+			want:    0,
+			wantErr: service.ErrTicketTypeCapacity,
+		},
+		{
+			name:       "permission denied",
+			ticketType: &models.TicketType{EventId: 2, Name: "VIP", Capacity: 10},
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 2).Return(&models.EventResponse{Id: 2, Creator: "user2"}, nil)
+			},
+			want:    0,
+			wantErr: service.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.CreateTicketType(ctx, tt.ticketType)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_GetTicketTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	general := &models.TicketType{Id: 1, EventId: 1, Name: "General", Capacity: 100}
+	speaker := &models.TicketType{Id: 2, EventId: 1, Name: "Speaker", Capacity: 5, Hidden: true}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []*models.TicketType
+	}{
+		{
+			name: "creator sees hidden ticket types",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: creator}),
+			want: []*models.TicketType{general, speaker},
+		},
+		{
+			name: "user",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: "user2"}),
+			want: []*models.TicketType{general},
+		},
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+			want: []*models.TicketType{general},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+			mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{general, speaker}, nil)
+
+			got, err := eventService.GetTicketTypes(tt.ctx, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_ChangeGuests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	user := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: user})
+	event := &models.EventResponse{Id: 1, StartDate: time.Now().Add(24 * time.Hour), MaxGuests: 3}
+	closed := time.Now().Add(-time.Hour)
+	started := &models.EventResponse{Id: 1, StartDate: time.Now().Add(time.Hour), MaxGuests: 3, RegistrationClosesAt: &closed}
+	active := &models.Registration{EventId: 1, UserId: user, Status: models.RegistrationActive, Guests: 1}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		guests  int
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:   "more guests",
+			ctx:    ctx,
+			guests: 3,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().SetGuests(gomock.Any(), user, 1, 3).Return(nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "fewer guests",
+			ctx:    ctx,
+			guests: 0,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(started, nil)
+				mockEURepo.EXPECT().SetGuests(gomock.Any(), user, 1, 0).Return(nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "unchanged",
+			ctx:    ctx,
+			guests: 1,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "pending registration",
+			ctx:    ctx,
+			guests: 2,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).
+					Return(&models.Registration{EventId: 1, UserId: user, Status: models.RegistrationPending}, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(started, nil)
+				mockEURepo.EXPECT().SetGuests(gomock.Any(), user, 1, 2).Return(nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "registration closed",
+			ctx:    ctx,
+			guests: 2,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(started, nil)
+			},
+			wantErr: service.ErrRegistrationClosed,
+		},
+		{
+			name:   "too many guests",
+			ctx:    ctx,
+			guests: 4,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+			},
+			wantErr: service.ErrTooManyGuests,
+		},
+		{
+			name:   "event full",
+			ctx:    ctx,
+			guests: 3,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().SetGuests(gomock.Any(), user, 1, 3).Return(repositories.ErrMaxRegistered)
+			},
+			wantErr: service.ErrMaxRegistered,
+		},
+		{
+			name:   "ticket type sold out",
+			ctx:    ctx,
+			guests: 3,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().SetGuests(gomock.Any(), user, 1, 3).Return(repositories.ErrSoldOut)
+			},
+			wantErr: service.ErrSoldOut,
+		},
+		{
+			name:   "repository error",
+			ctx:    ctx,
+			guests: 3,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).Return(active, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().SetGuests(gomock.Any(), user, 1, 3).Return(assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:   "not registered",
+			ctx:    ctx,
+			guests: 1,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), user, 1).
+					Return(&models.Registration{Status: models.RegistrationCancelled}, nil)
+			},
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			guests:  1,
+			wantErr: service.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := eventService.ChangeGuests(tt.ctx, user, 1, tt.guests)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_RegisterGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}, Registration: config.Registration{MaxGroupSize: 3}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: "lead"})
+	event := &models.EventResponse{Id: 1, StartDate: time.Now().Add(24 * time.Hour)}
+	moderated := &models.EventResponse{Id: 2, StartDate: time.Now().Add(24 * time.Hour), RequiresApproval: true}
+
+	mockQuestionRepo.EXPECT().
+		GetAllByEvent(gomock.Any(), gomock.Any()).
+		Return([]*models.Question{}, nil).
+		AnyTimes()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		eventID int
+		userIDs []string
+		partial bool
+		setup   func()
+		want    []*models.GroupOutcome
+		wantErr error
+	}{
+		{
+			name:    "all registered",
+			ctx:     ctx,
+			eventID: 1,
+			userIDs: []string{"user1", "USER2", "user1"},
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil)
+				mockEURepo.EXPECT().
+					CreateGroup(gomock.Any(), &models.Registration{EventId: 1, Status: models.RegistrationActive}, []string{"user1", "user2"}).
+					Return([]string{"user1", "user2"}, nil)
+				mockCache.EXPECT().
+					Del(gomock.Any(), "event:1").
+					Return(nil)
+			},
+			want: []*models.GroupOutcome{
+				{UserId: "user1", Outcome: models.GroupRegistered},
+				{UserId: "user2", Outcome: models.GroupRegistered},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "already registered are skipped",
+			ctx:     ctx,
+			eventID: 2,
+			userIDs: []string{"user1", "user2"},
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 2).Return(moderated, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 2).Return([]*models.TicketType{}, nil)
+				mockEURepo.EXPECT().
+					CreateGroup(gomock.Any(), &models.Registration{EventId: 2, Status: models.RegistrationPending}, []string{"user1", "user2"}).
+					Return([]string{"user2"}, nil)
+				mockCache.EXPECT().
+					Del(gomock.Any(), "event:2").
+					Return(nil)
+			},
+			want: []*models.GroupOutcome{
+				{UserId: "user1", Outcome: models.GroupSkipped},
+				{UserId: "user2", Outcome: models.GroupPending},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "not enough seats",
+			ctx:     ctx,
+			eventID: 1,
+			userIDs: []string{"user1", "user2"},
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil)
+				mockEURepo.EXPECT().
+					CreateGroup(gomock.Any(), gomock.Any(), []string{"user1", "user2"}).
+					Return(nil, repositories.ErrMaxRegistered)
+			},
+			want:    nil,
+			wantErr: service.ErrMaxRegistered,
+		},
+		{
+			name:    "ticket type sold out",
+			ctx:     ctx,
+			eventID: 1,
+			userIDs: []string{"user1", "user2"},
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil)
+				mockEURepo.EXPECT().
+					CreateGroup(gomock.Any(), gomock.Any(), []string{"user1", "user2"}).
+					Return(nil, repositories.ErrSoldOut)
+			},
+			want:    nil,
+			wantErr: service.ErrSoldOut,
+		},
+		{
+			name:    "repository error",
+			ctx:     ctx,
+			eventID: 1,
+			userIDs: []string{"user1", "user2"},
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil)
+				mockEURepo.EXPECT().
+					CreateGroup(gomock.Any(), gomock.Any(), []string{"user1", "user2"}).
+					Return(nil, assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:    "partial",
+			ctx:     ctx,
+			eventID: 1,
+			userIDs: []string{"user1", "user2", "user3"},
+			partial: true,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil).Times(3)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil).Times(3)
+				mockEURepo.EXPECT().Get(gomock.Any(), "user1", 1).Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 1, UserId: "user1", Status: models.RegistrationActive}).
+					Return(nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), "user2", 1).Return(&models.Registration{Status: models.RegistrationActive}, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), "user3", 1).Return(nil, repositories.ErrRecordNotFound)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 1, UserId: "user3", Status: models.RegistrationActive}).
+					Return(repositories.ErrMaxRegistered)
+			},
+			want: []*models.GroupOutcome{
+				{UserId: "user1", Outcome: models.GroupRegistered},
+				{UserId: "user2", Outcome: models.GroupSkipped, Err: service.ErrRegistered},
+				{UserId: "user3", Outcome: models.GroupFailed, Err: service.ErrMaxRegistered},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "registration closed",
+			ctx:     ctx,
+			eventID: 3,
+			userIDs: []string{"user1"},
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 3).Return(&models.EventResponse{Id: 3, StartDate: time.Now().Add(-time.Hour)}, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrRegistrationClosed,
+		},
+		{
+			name:    "empty group",
+			ctx:     ctx,
+			eventID: 1,
+			want:    nil,
+			wantErr: service.ErrGroupEmpty,
+		},
+		{
+			name:    "group too large",
+			ctx:     ctx,
+			eventID: 1,
+			userIDs: []string{"user1", "user2", "user3", "user4"},
+			want:    nil,
+			wantErr: service.ErrGroupTooLarge,
+		},
+		{
+			name:    "unauthenticated",
+			ctx:     context.Background(),
+			eventID: 1,
+			userIDs: []string{"user1"},
+			want:    nil,
+			wantErr: service.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.RegisterGroup(tt.ctx, tt.eventID, tt.userIDs, 0, tt.partial)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_TransferRegistration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	from := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	to := "9b1c7d2e-3f4a-4b5c-8d6e-7f8091a2b3c4"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: from})
+	creator := "0b6e3b52-8f3c-4f0e-a7a4-5d2c1e9f8a7b"
+	event := &models.EventResponse{Id: 1, StartDate: time.Now().Add(time.Hour), AllowTransfers: true}
+	private := &models.EventResponse{Id: 1, StartDate: time.Now().Add(time.Hour), AllowTransfers: true, Creator: creator, Visibility: models.VisibilityPrivate}
+	active := &models.Registration{EventId: 1, UserId: from, Status: models.RegistrationActive}
+	checkedIn := time.Now()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		to      string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "success",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), from, 1).Return(active, nil)
+				mockEURepo.EXPECT().Transfer(gomock.Any(), 1, from, to).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "transfers not allowed",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).
+					Return(&models.EventResponse{Id: 1, StartDate: time.Now().Add(time.Hour)}, nil)
+			},
+			wantErr: service.ErrTransferNotAllowed,
+		},
+		{
+			name: "event started",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).
+					Return(&models.EventResponse{Id: 1, StartDate: time.Now().Add(-time.Hour), AllowTransfers: true}, nil)
+			},
+			wantErr: service.ErrTransferClosed,
+		},
+		{
+			name: "not registered",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), from, 1).
+					Return(&models.Registration{Status: models.RegistrationPending}, nil)
+			},
+			wantErr: service.ErrNotRegistered,
+		},
+		{
+			name: "checked in",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), from, 1).
+					Return(&models.Registration{Status: models.RegistrationActive, CheckedInAt: &checkedIn}, nil)
+			},
+			wantErr: service.ErrCheckedIn,
+		},
+		{
+			name: "target already registered",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), from, 1).Return(active, nil)
+				mockEURepo.EXPECT().Transfer(gomock.Any(), 1, from, to).Return(repositories.ErrAlreadyExists)
+			},
+			wantErr: service.ErrRegistered,
+		},
+		{
+			name: "repository error",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), from, 1).Return(active, nil)
+				mockEURepo.EXPECT().Transfer(gomock.Any(), 1, from, to).Return(assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:    "to self",
+			ctx:     ctx,
+			to:      strings.ToUpper(from),
+			wantErr: service.ErrTransferToSelf,
+		},
+		{
+			name:    "invalid recipient",
+			ctx:     ctx,
+			to:      "user2",
+			wantErr: service.ErrInvalidRecipient,
+		},
+		{
+			name: "private event to an invited user",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+				mockInviteRepo.EXPECT().IsInvited(gomock.Any(), 1, to).Return(true, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), from, 1).Return(active, nil)
+				mockEURepo.EXPECT().Transfer(gomock.Any(), 1, from, to).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "private event to a user without an invite",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+				mockInviteRepo.EXPECT().IsInvited(gomock.Any(), 1, to).Return(false, nil)
+			},
+			wantErr: service.ErrRecipientNotInvited,
+		},
+		{
+			name: "private event transferred by the creator",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: creator}),
+			to:   to,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(private, nil)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+				mockEURepo.EXPECT().Get(gomock.Any(), from, 1).Return(active, nil)
+				mockEURepo.EXPECT().Transfer(gomock.Any(), 1, from, to).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "repository error on invite",
+			ctx:  ctx,
+			to:   to,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+				mockInviteRepo.EXPECT().IsInvited(gomock.Any(), 1, to).Return(false, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name: "another user",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: to}),
+			to:   to,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(&models.EventResponse{Id: 1, Creator: "creator"}, nil)
+			},
+			wantErr: service.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := eventService.TransferRegistration(tt.ctx, 1, from, tt.to)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_RegisterAnswers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	ctx := context.Background()
+	event := &models.EventResponse{Id: 1, StartDate: time.Now().Add(24 * time.Hour)}
+	questions := []*models.Question{
+		{Id: 1, EventId: 1, Label: "T-shirt size", Type: models.QuestionSingleChoice, Options: []string{"S", "M", "L"}, Required: true},
+	}
+	answers := []models.Answer{{QuestionId: 1, Values: []string{"M"}}}
+
+	tests := []struct {
+		name    string
+		answers []models.Answer
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:    "answers are stored",
+			answers: answers,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), "user1", 1).Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil)
+				mockQuestionRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(questions, nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+				mockEURepo.EXPECT().
+					Register(gomock.Any(), &models.Registration{EventId: 1, UserId: "user1", Status: models.RegistrationActive, Answers: answers}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "required answer missing",
+			answers: nil,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), "user1", 1).Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil)
+				mockQuestionRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(questions, nil)
+			},
+			wantErr: service.ErrAnswerRequired,
+		},
+		{
+			name:    "repository error on questions",
+			answers: answers,
+			setup: func() {
+				mockEURepo.EXPECT().Get(gomock.Any(), "user1", 1).Return(nil, repositories.ErrRecordNotFound)
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+				mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil)
+				mockQuestionRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(nil, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := eventService.Register(ctx, "user1", 1, 0, 0, tt.answers, "")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_CreateQuestion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	question := &models.Question{EventId: 1, Label: "Vegan", Type: models.QuestionBoolean}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		question *models.Question
+		setup    func()
+		want     int
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			ctx:      ctx,
+			question: question,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockQuestionRepo.EXPECT().Create(gomock.Any(), question).Return(7, nil)
+			},
+			want:    7,
+			wantErr: nil,
+		},
+		{
+			name:     "invalid question",
+			ctx:      ctx,
+			question: &models.Question{EventId: 1, Label: "Vegan", Type: "yes/no"},
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			},
+			want:    0,
+			wantErr: service.ErrQuestionType,
+		},
+		{
+			name:     "not the creator",
+			ctx:      auth.WithCaller(context.Background(), &auth.Caller{UserId: "user2"}),
+			question: question,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			},
+			want:    0,
+			wantErr: service.ErrPermissionDenied,
+		},
+		{
+			name:     "repository error",
+			ctx:      ctx,
+			question: question,
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockQuestionRepo.EXPECT().Create(gomock.Any(), question).Return(0, assert.AnError)
+			},
+			want:    0,
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.CreateQuestion(tt.ctx, tt.question)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_DeleteQuestion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+
+	mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil).Times(2)
+	mockQuestionRepo.EXPECT().Delete(gomock.Any(), 7, 1).Return(nil)
+	mockQuestionRepo.EXPECT().Delete(gomock.Any(), 8, 1).Return(repositories.ErrRecordNotFound)
+
+	assert.NoError(t, eventService.DeleteQuestion(ctx, 1, 7))
+	assert.ErrorIs(t, eventService.DeleteQuestion(ctx, 1, 8), service.ErrQuestionNotFound)
+}
+
+func TestEventService_ExportAnswers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	questions := []*models.Question{{Id: 1, EventId: 1, Label: "Vegan", Type: models.QuestionBoolean}}
+	active := &models.Registration{EventId: 1, UserId: "user1", Status: models.RegistrationActive, Answers: []models.Answer{{QuestionId: 1, Values: []string{"true"}}}}
+	pending := &models.Registration{EventId: 1, UserId: "user2", Status: models.RegistrationPending}
+	cancelled := &models.Registration{EventId: 1, UserId: "user3", Status: models.RegistrationCancelled}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *models.AnswerExport
+		wantErr error
+	}{
+		{
+			name: "success",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: creator}),
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockQuestionRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(questions, nil)
+				mockEURepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.Registration{active, pending, cancelled}, nil)
+			},
+			want:    &models.AnswerExport{EventId: 1, Questions: questions, Registrations: []*models.Registration{active, pending}},
+			wantErr: nil,
+		},
+		{
+			name: "not the creator",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"}),
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrPermissionDenied,
+		},
+		{
+			name: "repository error",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: creator}),
+			setup: func() {
+				mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+				mockQuestionRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(questions, nil)
+				mockEURepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return(nil, assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := eventService.ExportAnswers(tt.ctx, 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_GetByIdVisibility(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	private := &models.EventResponse{Id: 1, Creator: creator, Visibility: models.VisibilityPrivate}
+	unlisted := &models.EventResponse{Id: 2, Creator: creator, Visibility: models.VisibilityUnlisted}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		id      int
+		setup   func()
+		want    *models.EventResponse
+		wantErr error
+	}{
+		{
+			name: "unlisted event is visible by id",
+			ctx:  context.Background(),
+			id:   2,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 2).Return(unlisted, nil)
+			},
+			want:    unlisted,
+			wantErr: nil,
+		},
+		{
+			name: "private event is visible to the creator",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: creator}),
+			id:   1,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+			},
+			want:    private,
+			wantErr: nil,
+		},
+		{
+			name: "private event is visible to an invited user",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"}),
+			id:   1,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+				mockInviteRepo.EXPECT().IsInvited(gomock.Any(), 1, "user1").Return(true, nil)
+			},
+			want:    private,
+			wantErr: nil,
+		},
+		{
+			name: "private event is hidden from other users",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: "user2"}),
+			id:   1,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+				mockInviteRepo.EXPECT().IsInvited(gomock.Any(), 1, "user2").Return(false, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name: "private event is hidden from anonymous callers",
+			ctx:  context.Background(),
+			id:   1,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name: "invite repository error",
+			ctx:  auth.WithCaller(context.Background(), &auth.Caller{UserId: "user3"}),
+			id:   1,
+			setup: func() {
+				mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(private, nil)
+				mockInviteRepo.EXPECT().IsInvited(gomock.Any(), 1, "user3").Return(false, assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := eventService.GetById(tt.ctx, tt.id)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_ListVisibility(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	anonymous := context.Background()
+	user := auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"})
+	admin := auth.WithCaller(context.Background(), &auth.Caller{UserId: "admin", Role: auth.RoleAdmin})
+
+	mockRepo.EXPECT().GetAll(gomock.Any(), &models.Viewer{}).Return([]*models.EventResponse{}, nil)
+	mockRepo.EXPECT().GetAllByStatus(gomock.Any(), models.StatusPublished, &models.Viewer{UserId: "user1"}).Return([]*models.EventResponse{}, nil)
+	mockRepo.EXPECT().GetAllByCreator(gomock.Any(), "user2", false, nil).Return([]*models.EventResponse{}, nil)
+	mockRepo.EXPECT().GetAllByUser(gomock.Any(), "user1", &models.Viewer{UserId: "user1"}).Return([]*models.EventRegistration{}, nil)
+
+	_, err := eventService.GetAll(anonymous)
+	assert.NoError(t, err)
+	_, err = eventService.GetAllByStatus(user, models.StatusPublished)
+	assert.NoError(t, err)
+	_, err = eventService.GetAllByCreator(admin, "user2", false)
+	assert.NoError(t, err)
+	_, err = eventService.GetAllByUser(user, "user1")
+	assert.NoError(t, err)
+}
+
+func TestEventService_RegisterInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	event := &models.EventResponse{Id: 1, Creator: creator, StartDate: time.Now().Add(time.Hour), Visibility: models.VisibilityPrivate}
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		userID  string
+		code    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:    "no code",
+			ctx:     context.Background(),
+			userID:  "user1",
+			code:    "",
+			setup:   func() {},
+			wantErr: service.ErrInviteRequired,
+		},
+		{
+			name:   "unknown code",
+			ctx:    context.Background(),
+			userID: "user1",
+			code:   "unknown",
+			setup: func() {
+				mockInviteRepo.EXPECT().GetByCode(gomock.Any(), 1, "unknown").Return(nil, repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrInvalidInvite,
+		},
+		{
+			name:   "revoked code",
+			ctx:    context.Background(),
+			userID: "user1",
+			code:   "revoked",
+			setup: func() {
+				mockInviteRepo.EXPECT().GetByCode(gomock.Any(), 1, "revoked").Return(&models.Invite{Code: "revoked", EventId: 1, RevokedAt: &revokedAt}, nil)
+			},
+			wantErr: service.ErrInvalidInvite,
+		},
+		{
+			name:   "personal code of another user",
+			ctx:    context.Background(),
+			userID: "user1",
+			code:   "personal",
+			setup: func() {
+				mockInviteRepo.EXPECT().GetByCode(gomock.Any(), 1, "personal").Return(&models.Invite{Code: "personal", EventId: 1, UserId: "user2"}, nil)
+			},
+			wantErr: service.ErrInvalidInvite,
+		},
+		{
+			name:   "invite repository error",
+			ctx:    context.Background(),
+			userID: "user1",
+			code:   "code",
+			setup: func() {
+				mockInviteRepo.EXPECT().GetByCode(gomock.Any(), 1, "code").Return(nil, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:   "shared code",
+			ctx:    context.Background(),
+			userID: "user1",
+			code:   "shared",
+			setup: func() {
+				mockInviteRepo.EXPECT().GetByCode(gomock.Any(), 1, "shared").Return(&models.Invite{Code: "shared", EventId: 1}, nil)
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+				mockEURepo.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "the creator needs no code",
+			ctx:    auth.WithCaller(context.Background(), &auth.Caller{UserId: creator}),
+			userID: "user2",
+			code:   "",
+			setup: func() {
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+				mockEURepo.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEURepo.EXPECT().Get(gomock.Any(), tt.userID, 1).Return(nil, repositories.ErrRecordNotFound)
+			mockCache.EXPECT().GetEvent(gomock.Any(), 1).Return(event, nil)
+			mockTicketTypeRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.TicketType{}, nil).AnyTimes()
+			mockQuestionRepo.EXPECT().GetAllByEvent(gomock.Any(), 1).Return([]*models.Question{}, nil).AnyTimes()
+			tt.setup()
+
+			err := eventService.Register(tt.ctx, tt.userID, 1, 0, 0, nil, tt.code)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEventService_Invites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), gomock.Any(), gomock.Any()).Return("", repositories.ErrRecordNotFound).AnyTimes()
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	invitee := "6F1C3A9E-2B4D-4E8A-9C7B-1D2E3F4A5B6C"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator, Visibility: models.VisibilityPrivate}
+
+	mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil).AnyTimes()
+
+	var created *models.Invite
+	mockInviteRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, invite *models.Invite) error {
+		created = invite
+		return nil
+	})
+	invite, err := eventService.CreateInvite(ctx, 1, invitee)
+	assert.NoError(t, err)
+	assert.Same(t, created, invite)
+	assert.Len(t, invite.Code, 22)
+	assert.Equal(t, strings.ToLower(invitee), invite.UserId)
+	assert.Equal(t, creator, invite.CreatedBy)
+
+	_, err = eventService.CreateInvite(ctx, 1, "not-a-uuid")
+	assert.ErrorIs(t, err, service.ErrInvalidInvitee)
+
+	mockInviteRepo.EXPECT().Revoke(gomock.Any(), 1, invite.Code).Return(nil)
+	mockInviteRepo.EXPECT().Revoke(gomock.Any(), 1, "unknown").Return(repositories.ErrRecordNotFound)
+	assert.NoError(t, eventService.RevokeInvite(ctx, 1, invite.Code))
+	assert.ErrorIs(t, eventService.RevokeInvite(ctx, 1, "unknown"), service.ErrInviteNotFound)
+
+	other := auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"})
+	_, err = eventService.GetInvites(other, 1)
+	assert.ErrorIs(t, err, service.ErrPermissionDenied)
+}
+
+func TestEventService_OrganizerRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	event := &models.EventResponse{Id: 1, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"}
+	attendance := &models.Attendance{EventId: 1, Registered: 10}
+
+	tests := []struct {
+		name    string
+		role    string
+		err     error
+		wantErr error
+	}{
+		{name: "viewer", role: models.OrganizerViewer, wantErr: nil},
+		{name: "checkin", role: models.OrganizerCheckIn, wantErr: nil},
+		{name: "editor", role: models.OrganizerEditor, wantErr: nil},
+		{name: "unknown role", role: "moderator", wantErr: service.ErrPermissionDenied},
+		{name: "not an organizer", err: repositories.ErrRecordNotFound, wantErr: service.ErrPermissionDenied},
+		{name: "repository error", err: assert.AnError, wantErr: service.ErrRepositoryError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"})
+			mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), 1, "user1").Return(tt.role, tt.err)
+			if tt.wantErr == nil {
+				mockEURepo.EXPECT().GetAttendance(gomock.Any(), 1).Return(attendance, nil)
+			}
+
+			_, err := eventService.GetAttendance(ctx, 1)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("viewer cannot update", func(t *testing.T) {
+		ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"})
+		mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+		mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), 1, "user1").Return(models.OrganizerViewer, nil)
+
+		err := eventService.Update(ctx, &models.EventUpdateRequest{Id: 1, Fields: []string{models.FieldTitle}, Title: "New title"})
+
+		assert.ErrorIs(t, err, service.ErrPermissionDenied)
+	})
+
+	t.Run("editor cannot delete", func(t *testing.T) {
+		ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"})
+		mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+		mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), 1, "user1").Return(models.OrganizerEditor, nil)
+
+		err := eventService.DeleteById(ctx, 1, 0)
+
+		assert.ErrorIs(t, err, service.ErrPermissionDenied)
+	})
+}
+
+func TestEventService_AddOrganizer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	colleague := "6F1C3A9E-2B4D-4E8A-9C7B-1D2E3F4A5B6C"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		userID  string
+		role    string
+		setup   func()
+		want    *models.Organizer
+		wantErr error
+	}{
+		{
+			name:   "success",
+			ctx:    ctx,
+			userID: colleague,
+			role:   models.OrganizerEditor,
+			setup: func() {
+				mockOrganizerRepo.EXPECT().Add(gomock.Any(), &models.Organizer{EventId: 1, UserId: strings.ToLower(colleague), Role: models.OrganizerEditor}).Return(nil)
+			},
+			want:    &models.Organizer{EventId: 1, UserId: strings.ToLower(colleague), Role: models.OrganizerEditor},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid role",
+			ctx:     ctx,
+			userID:  colleague,
+			role:    models.OrganizerOwner,
+			setup:   func() {},
+			want:    nil,
+			wantErr: service.ErrOrganizerRole,
+		},
+		{
+			name:    "the owner",
+			ctx:     ctx,
+			userID:  creator,
+			role:    models.OrganizerViewer,
+			setup:   func() {},
+			want:    nil,
+			wantErr: service.ErrOwnerRole,
+		},
+		{
+			name:   "repository error",
+			ctx:    ctx,
+			userID: colleague,
+			role:   models.OrganizerViewer,
+			setup: func() {
+				mockOrganizerRepo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(assert.AnError)
+			},
+			want:    nil,
+			wantErr: service.ErrRepositoryError,
+		},
+		{
+			name:   "an editor cannot add organizers",
+			ctx:    auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"}),
+			userID: colleague,
+			role:   models.OrganizerViewer,
+			setup: func() {
+				mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), 1, "user1").Return(models.OrganizerEditor, nil)
+			},
+			want:    nil,
+			wantErr: service.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			tt.setup()
+
+			got, err := eventService.AddOrganizer(tt.ctx, 1, tt.userID, tt.role)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventService_RemoveOrganizer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	event := &models.EventResponse{Id: 1, Creator: creator}
+	owner := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	organizer := auth.WithCaller(context.Background(), &auth.Caller{UserId: "user1"})
+
+	mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil).Times(3)
+	mockOrganizerRepo.EXPECT().Remove(gomock.Any(), 1, "user1").Return(nil).Times(2)
+	mockOrganizerRepo.EXPECT().Remove(gomock.Any(), 1, "user2").Return(repositories.ErrRecordNotFound)
+	mockOrganizerRepo.EXPECT().GetRole(gomock.Any(), 1, "user1").Return(models.OrganizerEditor, nil)
+
+	assert.NoError(t, eventService.RemoveOrganizer(owner, 1, "user1"))
+	assert.ErrorIs(t, eventService.RemoveOrganizer(owner, 1, "user2"), service.ErrOrganizerNotFound)
+	assert.NoError(t, eventService.RemoveOrganizer(organizer, 1, "user1"))
+	assert.ErrorIs(t, eventService.RemoveOrganizer(organizer, 1, "user3"), service.ErrPermissionDenied)
+	assert.ErrorIs(t, eventService.RemoveOrganizer(context.Background(), 1, "user1"), service.ErrUnauthenticated)
+}
+
+func TestEventService_TransferOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocksRepo.NewMockIEventRepository(ctrl)
+	mockEURepo := mocksRepo.NewMockIEventUserRepository(ctrl)
+	mockHistoryRepo := mocksRepo.NewMockIEventHistoryRepository(ctrl)
+	mockTicketTypeRepo := mocksRepo.NewMockITicketTypeRepository(ctrl)
+	mockQuestionRepo := mocksRepo.NewMockIQuestionRepository(ctrl)
+	mockInviteRepo := mocksRepo.NewMockIInviteRepository(ctrl)
+	mockOrganizerRepo := mocksRepo.NewMockIOrganizerRepository(ctrl)
+	mockCache := mocks.NewMockCache(ctrl)
+	logger := logger.GetLogger("test")
+	cfg := &config.Config{Redis: config.Redis{CacheTTL: time.Hour}}
+
+	eventService := New(mockRepo, mockEURepo, mockHistoryRepo, mockTicketTypeRepo, mockQuestionRepo, mockInviteRepo, mockOrganizerRepo, mockCache, logger, cfg)
+
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	to := "6f1c3a9e-2b4d-4e8a-9c7b-1d2e3f4a5b6c"
+	ctx := auth.WithCaller(context.Background(), &auth.Caller{UserId: creator})
+	event := &models.EventResponse{Id: 1, Creator: creator, Version: 3}
+
+	tests := []struct {
+		name    string
+		to      string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "success",
+			to:   strings.ToUpper(to),
+			setup: func() {
+				mockOrganizerRepo.EXPECT().TransferOwnership(gomock.Any(), 1, to, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, _ string, history *models.EventHistory) (int, error) {
+					assert.Equal(t, models.ActionTransfer, history.Action)
+					assert.Equal(t, models.FieldChange{Old: creator, New: to}, history.Changes[models.FieldCreator])
+					assert.Equal(t, to, history.Snapshot.Creator)
+					return 4, nil
+				})
+				mockCache.EXPECT().Del(gomock.Any(), "event:1").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "to the owner",
+			to:      creator,
+			setup:   func() {},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid user",
+			to:      "user1",
+			setup:   func() {},
+			wantErr: service.ErrInvalidOrganizer,
+		},
+		{
+			name: "event not found",
+			to:   to,
+			setup: func() {
+				mockOrganizerRepo.EXPECT().TransferOwnership(gomock.Any(), 1, to, gomock.Any()).Return(0, repositories.ErrRecordNotFound)
+			},
+			wantErr: service.ErrRecordNotFound,
+		},
+		{
+			name: "repository error",
+			to:   to,
+			setup: func() {
+				mockOrganizerRepo.EXPECT().TransferOwnership(gomock.Any(), 1, to, gomock.Any()).Return(0, assert.AnError)
+			},
+			wantErr: service.ErrRepositoryError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo.EXPECT().GetById(gomock.Any(), 1).Return(event, nil)
+			tt.setup()
+
+			err := eventService.TransferOwnership(ctx, 1, tt.to)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
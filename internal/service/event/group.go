@@ -0,0 +1,155 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/metrics"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RegisterGroup registers the users for the event with the ticket type, skipping
+// users that are already registered, pending or rejected. By default either all
+// the other users are registered or none of them when there are not enough seats.
+// In partial mode every user is registered on their own and the outcome of each
+// user is reported, so a full event only fails the users that did not fit.
+// A group registration gives no answers, so it fails when the registration form
+// of the event has required questions. Only the editors of a private event can
+// register a group for it.
+func (s *EventService) RegisterGroup(ctx context.Context, event_id int, user_ids []string, ticket_type_id int, partial bool) ([]*models.GroupOutcome, error) {
+	ctx, span := tracer.Start(ctx, "EventService.RegisterGroup", trace.WithAttributes(attribute.Int("event.id", event_id), attribute.Int("group.size", len(user_ids))))
+	defer span.End()
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		s.log(ctx).Warn(
+			"Unauthenticated group registration",
+			slog.Int("event_id", event_id),
+		)
+		return nil, service.ErrUnauthenticated
+	}
+
+	user_ids = unique(user_ids)
+	if err := s.validation.group(user_ids); err != nil {
+		s.log(ctx).Info(
+			"Invalid group",
+			slog.Int("event_id", event_id),
+			slog.Int("size", len(user_ids)),
+		)
+		return nil, err
+	}
+
+	event, err := s.get(ctx, event_id)
+	if err != nil {
+		return nil, err
+	}
+	role, err := s.role(ctx, caller, event)
+	if err != nil {
+		return nil, err
+	}
+	if event.Visibility == models.VisibilityPrivate && !permits(role, models.OrganizerEditor) {
+		s.log(ctx).Info(
+			"Group registration for a private event",
+			slog.Int("event_id", event_id),
+			slog.String("user_id", caller.UserId),
+		)
+		return nil, service.ErrInviteRequired
+	}
+	if err := s.validation.register(event); err != nil {
+		s.log(ctx).Info(
+			"Registration is not open",
+			slog.Int("event_id", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, err
+	}
+	if err := s.checkTicketType(ctx, event_id, ticket_type_id); err != nil {
+		return nil, err
+	}
+	if err := s.checkAnswers(ctx, event_id, nil); err != nil {
+		return nil, err
+	}
+
+	registered := models.GroupRegistered
+	if event.RequiresApproval {
+		registered = models.GroupPending
+	}
+
+	if partial {
+		outcomes := make([]*models.GroupOutcome, 0, len(user_ids))
+		for _, user_id := range user_ids {
+			outcome := &models.GroupOutcome{UserId: user_id, Outcome: registered}
+			err := s.Register(ctx, user_id, event_id, ticket_type_id, 0, nil, "")
+			if errors.Is(err, service.ErrRegistered) || errors.Is(err, service.ErrPending) || errors.Is(err, service.ErrRejected) {
+				outcome.Outcome, outcome.Err = models.GroupSkipped, err
+			} else if err != nil {
+				outcome.Outcome, outcome.Err = models.GroupFailed, err
+			}
+			outcomes = append(outcomes, outcome)
+		}
+		s.log(ctx).Info(
+			"Successful partial group registration",
+			slog.Int("event_id", event_id),
+			slog.Int("size", len(user_ids)),
+		)
+		return outcomes, nil
+	}
+
+	status := models.RegistrationActive
+	if event.RequiresApproval {
+		status = models.RegistrationPending
+	}
+	created, err := s.eventUserRepo.CreateGroup(ctx, &models.Registration{
+		EventId:      event_id,
+		Status:       status,
+		TicketTypeId: ticket_type_id,
+	}, user_ids)
+	if err != nil {
+		return nil, s.seatError(ctx, ticket_type_id, err)
+	}
+
+	s.invalidate(ctx, event_id)
+
+	done := make(map[string]bool, len(created))
+	for _, user_id := range created {
+		done[user_id] = true
+		if status == models.RegistrationActive {
+			metrics.Registered()
+		}
+	}
+	outcomes := make([]*models.GroupOutcome, 0, len(user_ids))
+	for _, user_id := range user_ids {
+		outcome := &models.GroupOutcome{UserId: user_id, Outcome: models.GroupSkipped}
+		if done[user_id] {
+			outcome.Outcome = registered
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	s.log(ctx).Info(
+		"Successful group registration",
+		slog.Int("event_id", event_id),
+		slog.Int("size", len(user_ids)),
+		slog.Int("registered", len(created)),
+	)
+	return outcomes, nil
+}
+
+// unique returns the user ids in lower case without duplicates, in their first order.
+func unique(user_ids []string) []string {
+	seen := make(map[string]bool, len(user_ids))
+	res := make([]string, 0, len(user_ids))
+	for _, user_id := range user_ids {
+		user_id = strings.ToLower(user_id)
+		if !seen[user_id] {
+			seen[user_id] = true
+			res = append(res, user_id)
+		}
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ChangeGuests changes the number of guests of an active or pending registration.
+// For an active registration the difference in seats is taken or given back at once,
+// more guests can only be added while the registration is open and fewer only
+// before the cancellation cut-off.
+func (s *EventService) ChangeGuests(ctx context.Context, user_id string, event_id int, guests int) error {
+	ctx, span := tracer.Start(ctx, "EventService.ChangeGuests", trace.WithAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if err := s.authorizeRegistration(ctx, user_id, event_id, models.OrganizerEditor); err != nil {
+		return err
+	}
+
+	registration, err := s.eventUserRepo.Get(ctx, user_id, event_id)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
+			"Error getting registration",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	if registration == nil || (registration.Status != models.RegistrationActive && registration.Status != models.RegistrationPending) {
+		s.log(ctx).Info(
+			"User is not registered",
+			slog.String("user_id", user_id),
+			slog.Int("event_id", event_id),
+		)
+		return service.ErrNotRegistered
+	}
+
+	event, err := s.get(ctx, event_id)
+	if err != nil {
+		return err
+	}
+	if err := s.validation.guests(event, guests); err != nil {
+		s.log(ctx).Info(
+			"Invalid number of guests",
+			slog.Int("event_id", event_id),
+			slog.Int("guests", guests),
+		)
+		return err
+	}
+	if guests == registration.Guests {
+		return nil
+	}
+	if registration.Status == models.RegistrationActive {
+		if guests > registration.Guests {
+			err = s.validation.register(event)
+		} else {
+			err = s.validation.cancel(event)
+		}
+		if err != nil {
+			s.log(ctx).Info(
+				"Guests can no longer be changed",
+				slog.Int("event_id", event_id),
+				slog.String("err", err.Error()),
+			)
+			return err
+		}
+	}
+
+	err = s.eventUserRepo.SetGuests(ctx, user_id, event_id, guests)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"User is not registered",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrNotRegistered
+		} else if errors.Is(err, repositories.ErrMaxRegistered) {
+			s.log(ctx).Info(
+				"Maximum number of users",
+			)
+			return service.ErrMaxRegistered
+		} else if errors.Is(err, repositories.ErrSoldOut) {
+			s.log(ctx).Info(
+				"Ticket type is sold out",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrSoldOut
+		}
+		s.log(ctx).Error(
+			"Error changing guests",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.invalidate(ctx, event_id)
+	s.log(ctx).Info(
+		"Successful changed guests",
+		slog.String("user_id", user_id),
+		slog.Int("event_id", event_id),
+		slog.Int("guests", guests),
+	)
+	return nil
+}
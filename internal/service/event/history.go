@@ -0,0 +1,170 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *EventService) GetHistory(ctx context.Context, event_id int) ([]*models.EventHistory, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetHistory", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	history, err := s.historyRepo.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting event history",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful getting event history",
+		slog.Int("event", event_id),
+	)
+	return history, nil
+}
+
+// GetRevision returns the event as it looked at the given revision (version).
+func (s *EventService) GetRevision(ctx context.Context, event_id int, revision int) (*models.EventResponse, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetRevision", trace.WithAttributes(attribute.Int("event.id", event_id), attribute.Int("event.revision", revision)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	history, err := s.historyRepo.GetRevision(ctx, event_id, revision)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Event revision not found",
+				slog.Int("event", event_id),
+				slog.Int("revision", revision),
+			)
+			return nil, service.ErrRecordNotFound
+		}
+		s.log(ctx).Error(
+			"Error getting event revision",
+			slog.Int("event", event_id),
+			slog.Int("revision", revision),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful getting event revision",
+		slog.Int("event", event_id),
+		slog.Int("revision", revision),
+	)
+	return history.Snapshot, nil
+}
+
+// entry returns the history entry with the caller as its actor. The repository
+// writes it in the same transaction as the change it describes.
+func entry(ctx context.Context, history *models.EventHistory) *models.EventHistory {
+	if caller, ok := auth.CallerFromContext(ctx); ok {
+		history.Actor = caller.UserId
+	}
+	return history
+}
+
+// created returns the fields of a new event as changes from nothing.
+func created(event *models.EventResponse) map[string]models.FieldChange {
+	return map[string]models.FieldChange{
+		models.FieldTitle:              {New: event.Title},
+		models.FieldAbout:              {New: event.About},
+		models.FieldStartDate:          {New: event.StartDate},
+		models.FieldLocation:           {New: event.Location},
+		models.FieldStatus:             {New: event.Status},
+		models.FieldMaxAttendees:       {New: event.MaxAttendees},
+		models.FieldRequiresApproval:   {New: event.RequiresApproval},
+		models.FieldRegistrationOpens:  {New: event.RegistrationOpensAt},
+		models.FieldRegistrationCloses: {New: event.RegistrationClosesAt},
+		models.FieldCancellationCutoff: {New: event.CancellationCutoff},
+		models.FieldMaxGuests:          {New: event.MaxGuests},
+		models.FieldAllowTransfers:     {New: event.AllowTransfers},
+		models.FieldVisibility:         {New: event.Visibility},
+	}
+}
+
+// applyUpdate returns the event after the update together with the fields it changed.
+func applyUpdate(current *models.EventResponse, event *models.EventUpdateRequest) (*models.EventResponse, map[string]models.FieldChange) {
+	updated := *current
+	changes := map[string]models.FieldChange{}
+
+	if event.Has(models.FieldTitle) && event.Title != current.Title {
+		changes[models.FieldTitle] = models.FieldChange{Old: current.Title, New: event.Title}
+		updated.Title = event.Title
+	}
+	if event.Has(models.FieldAbout) && event.About != current.About {
+		changes[models.FieldAbout] = models.FieldChange{Old: current.About, New: event.About}
+		updated.About = event.About
+	}
+	if event.Has(models.FieldStartDate) && !event.StartDate.Equal(current.StartDate) {
+		changes[models.FieldStartDate] = models.FieldChange{Old: current.StartDate, New: event.StartDate}
+		updated.StartDate = event.StartDate
+	}
+	if event.Has(models.FieldLocation) && event.Location != current.Location {
+		changes[models.FieldLocation] = models.FieldChange{Old: current.Location, New: event.Location}
+		updated.Location = event.Location
+	}
+	if event.Has(models.FieldStatus) && event.Status != current.Status {
+		changes[models.FieldStatus] = models.FieldChange{Old: current.Status, New: event.Status}
+		updated.Status = event.Status
+	}
+	if event.Has(models.FieldMaxAttendees) && event.MaxAttendees != current.MaxAttendees {
+		changes[models.FieldMaxAttendees] = models.FieldChange{Old: current.MaxAttendees, New: event.MaxAttendees}
+		updated.MaxAttendees = event.MaxAttendees
+	}
+	if event.Has(models.FieldRequiresApproval) && event.RequiresApproval != nil && *event.RequiresApproval != current.RequiresApproval {
+		changes[models.FieldRequiresApproval] = models.FieldChange{Old: current.RequiresApproval, New: *event.RequiresApproval}
+		updated.RequiresApproval = *event.RequiresApproval
+	}
+	if event.Has(models.FieldRegistrationOpens) && event.RegistrationOpensAt != nil && !equalTime(event.RegistrationOpensAt, current.RegistrationOpensAt) {
+		changes[models.FieldRegistrationOpens] = models.FieldChange{Old: current.RegistrationOpensAt, New: event.RegistrationOpensAt}
+		updated.RegistrationOpensAt = event.RegistrationOpensAt
+	}
+	if event.Has(models.FieldRegistrationCloses) && event.RegistrationClosesAt != nil && !equalTime(event.RegistrationClosesAt, current.RegistrationClosesAt) {
+		changes[models.FieldRegistrationCloses] = models.FieldChange{Old: current.RegistrationClosesAt, New: event.RegistrationClosesAt}
+		updated.RegistrationClosesAt = event.RegistrationClosesAt
+	}
+	if event.Has(models.FieldCancellationCutoff) && event.CancellationCutoff != nil &&
+		(current.CancellationCutoff == nil || *event.CancellationCutoff != *current.CancellationCutoff) {
+		changes[models.FieldCancellationCutoff] = models.FieldChange{Old: current.CancellationCutoff, New: event.CancellationCutoff}
+		updated.CancellationCutoff = event.CancellationCutoff
+	}
+	if event.Has(models.FieldMaxGuests) && event.MaxGuests != nil && *event.MaxGuests != current.MaxGuests {
+		changes[models.FieldMaxGuests] = models.FieldChange{Old: current.MaxGuests, New: *event.MaxGuests}
+		updated.MaxGuests = *event.MaxGuests
+	}
+	if event.Has(models.FieldAllowTransfers) && event.AllowTransfers != nil && *event.AllowTransfers != current.AllowTransfers {
+		changes[models.FieldAllowTransfers] = models.FieldChange{Old: current.AllowTransfers, New: *event.AllowTransfers}
+		updated.AllowTransfers = *event.AllowTransfers
+	}
+	if event.Has(models.FieldVisibility) && event.Visibility != nil && *event.Visibility != current.Visibility {
+		changes[models.FieldVisibility] = models.FieldChange{Old: current.Visibility, New: *event.Visibility}
+		updated.Visibility = *event.Visibility
+	}
+	return &updated, changes
+}
+
+func equalTime(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
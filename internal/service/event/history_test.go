@@ -0,0 +1,101 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyUpdate(t *testing.T) {
+	start := time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC)
+	current := &models.EventResponse{
+		Id:                1,
+		Title:             "Team Sync",
+		About:             "Weekly team meeting",
+		StartDate:         start,
+		Location:          "Zoom",
+		Status:            models.StatusDraft,
+		MaxAttendees:      20,
+		CurrentAttendance: 5,
+		Version:           2,
+	}
+	requiresApproval := true
+
+	tests := []struct {
+		name        string
+		event       *models.EventUpdateRequest
+		wantChanges map[string]models.FieldChange
+		wantTitle   string
+		wantStatus  string
+	}{
+		{
+			name: "full update",
+			event: &models.EventUpdateRequest{
+				Id:           1,
+				Title:        "Team Retro",
+				About:        "Weekly team meeting",
+				StartDate:    start,
+				Location:     "Zoom",
+				Status:       models.StatusPublished,
+				MaxAttendees: 20,
+			},
+			wantChanges: map[string]models.FieldChange{
+				models.FieldTitle:  {Old: "Team Sync", New: "Team Retro"},
+				models.FieldStatus: {Old: models.StatusDraft, New: models.StatusPublished},
+			},
+			wantTitle:  "Team Retro",
+			wantStatus: models.StatusPublished,
+		},
+		{
+			name: "fields outside the mask are kept",
+			event: &models.EventUpdateRequest{
+				Id:     1,
+				Title:  "Team Retro",
+				Fields: []string{models.FieldTitle},
+			},
+			wantChanges: map[string]models.FieldChange{
+				models.FieldTitle: {Old: "Team Sync", New: "Team Retro"},
+			},
+			wantTitle:  "Team Retro",
+			wantStatus: models.StatusDraft,
+		},
+		{
+			name: "approval switched on",
+			event: &models.EventUpdateRequest{
+				Id:               1,
+				RequiresApproval: &requiresApproval,
+				Fields:           []string{models.FieldRequiresApproval},
+			},
+			wantChanges: map[string]models.FieldChange{
+				models.FieldRequiresApproval: {Old: false, New: true},
+			},
+			wantTitle:  "Team Sync",
+			wantStatus: models.StatusDraft,
+		},
+		{
+			name: "nothing changed",
+			event: &models.EventUpdateRequest{
+				Id:     1,
+				Title:  "Team Sync",
+				Fields: []string{models.FieldTitle},
+			},
+			wantChanges: map[string]models.FieldChange{},
+			wantTitle:   "Team Sync",
+			wantStatus:  models.StatusDraft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, changes := applyUpdate(current, tt.event)
+
+			assert.Equal(t, tt.wantChanges, changes)
+			assert.Equal(t, tt.wantTitle, updated.Title)
+			assert.Equal(t, tt.wantStatus, updated.Status)
+			assert.Equal(t, current.CurrentAttendance, updated.CurrentAttendance)
+			assert.Equal(t, "Team Sync", current.Title)
+		})
+	}
+}
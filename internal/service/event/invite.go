@@ -0,0 +1,248 @@
+package event
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateInvite generates an invite code to the event. With a user id the invite is
+// personal, otherwise it can be shared as a link. The code is URL safe.
+func (s *EventService) CreateInvite(ctx context.Context, event_id int, user_id string) (*models.Invite, error) {
+	ctx, span := tracer.Start(ctx, "EventService.CreateInvite", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerEditor); err != nil {
+		return nil, err
+	}
+	if user_id != "" {
+		if _, err := uuid.Parse(user_id); err != nil {
+			s.log(ctx).Info(
+				"Invalid invited user",
+				slog.String("user_id", user_id),
+			)
+			return nil, service.ErrInvalidInvitee
+		}
+	}
+	caller, _ := auth.CallerFromContext(ctx)
+
+	code, err := inviteCode()
+	if err != nil {
+		s.log(ctx).Error(
+			"Error generating invite code",
+			slog.String("err", err.Error()),
+		)
+		return nil, err
+	}
+	invite := &models.Invite{
+		Code:      code,
+		EventId:   event_id,
+		UserId:    strings.ToLower(user_id),
+		CreatedBy: caller.UserId,
+	}
+	err = s.invites.Create(ctx, invite)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error create invite",
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful create invite",
+		slog.Int("event_id", event_id),
+		slog.String("user_id", invite.UserId),
+	)
+	return invite, nil
+}
+
+// GetInvites returns the invites of the event, revoked ones included.
+func (s *EventService) GetInvites(ctx context.Context, event_id int) ([]*models.Invite, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetInvites", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerEditor); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.invites.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting invites",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful getting invites",
+		slog.Int("event", event_id),
+	)
+	return invites, nil
+}
+
+// RevokeInvite stops the invite from being used to register, the registrations
+// already made with it are kept.
+func (s *EventService) RevokeInvite(ctx context.Context, event_id int, code string) error {
+	ctx, span := tracer.Start(ctx, "EventService.RevokeInvite", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerEditor); err != nil {
+		return err
+	}
+
+	err := s.invites.Revoke(ctx, event_id, code)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Invite not found",
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrInviteNotFound
+		}
+		s.log(ctx).Error(
+			"Error revoke invite",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful revoke invite",
+		slog.Int("event_id", event_id),
+	)
+	return nil
+}
+
+// checkVisible checks that the caller may see the event. A private event is
+// reported as not found to those who are neither organizing it nor invited or
+// registered with an active or pending registration.
+func (s *EventService) checkVisible(ctx context.Context, event *models.EventResponse) error {
+	if event.Visibility != models.VisibilityPrivate {
+		return nil
+	}
+	caller, ok := auth.CallerFromContext(ctx)
+	role, err := s.role(ctx, caller, event)
+	if err != nil {
+		return err
+	}
+	if permits(role, models.OrganizerViewer) {
+		return nil
+	}
+	if ok {
+		invited, err := s.invites.IsInvited(ctx, event.Id, caller.UserId)
+		if err != nil {
+			s.log(ctx).Error(
+				"Error checking invite",
+				slog.Int("event_id", event.Id),
+				slog.String("err", err.Error()),
+			)
+			return service.ErrRepositoryError
+		}
+		if invited {
+			return nil
+		}
+	}
+	s.log(ctx).Info(
+		"Private event is hidden from the caller",
+		slog.Int("id", event.Id),
+	)
+	return service.ErrRecordNotFound
+}
+
+// checkInvite checks that the user may register for the event with the invite code.
+// Only a private event needs a code, unless the caller is one of its editors. A personal
+// invite is only valid for its user.
+func (s *EventService) checkInvite(ctx context.Context, event *models.EventResponse, user_id string, code string) error {
+	if event.Visibility != models.VisibilityPrivate {
+		return nil
+	}
+	caller, _ := auth.CallerFromContext(ctx)
+	role, err := s.role(ctx, caller, event)
+	if err != nil {
+		return err
+	}
+	if permits(role, models.OrganizerEditor) {
+		return nil
+	}
+	if code == "" {
+		s.log(ctx).Info(
+			"Registration for a private event without an invite",
+			slog.String("user_id", user_id),
+			slog.Int("event_id", event.Id),
+		)
+		return service.ErrInviteRequired
+	}
+
+	invite, err := s.invites.GetByCode(ctx, event.Id, code)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
+			"Error getting invite",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	if invite == nil || invite.RevokedAt != nil || (invite.UserId != "" && !strings.EqualFold(invite.UserId, user_id)) {
+		s.log(ctx).Info(
+			"Invalid invite",
+			slog.String("user_id", user_id),
+			slog.Int("event_id", event.Id),
+		)
+		return service.ErrInvalidInvite
+	}
+	return nil
+}
+
+// checkRecipient checks that the registration of the event may be transferred to the
+// user. On a private event the user must be invited like checkVisible requires, unless
+// the caller is one of its editors.
+func (s *EventService) checkRecipient(ctx context.Context, event *models.EventResponse, user_id string) error {
+	if event.Visibility != models.VisibilityPrivate {
+		return nil
+	}
+	caller, _ := auth.CallerFromContext(ctx)
+	role, err := s.role(ctx, caller, event)
+	if err != nil {
+		return err
+	}
+	if permits(role, models.OrganizerEditor) {
+		return nil
+	}
+	invited, err := s.invites.IsInvited(ctx, event.Id, user_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error checking invite",
+			slog.Int("event_id", event.Id),
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	if !invited {
+		s.log(ctx).Info(
+			"Transfer of a private event registration to a user without an invite",
+			slog.String("user_id", user_id),
+			slog.Int("event_id", event.Id),
+		)
+		return service.ErrRecipientNotInvited
+	}
+	return nil
+}
+
+// inviteCode returns a random URL safe invite code.
+func inviteCode() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
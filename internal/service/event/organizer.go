@@ -0,0 +1,212 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetOrganizers returns the organizers of the event, the owner first.
+func (s *EventService) GetOrganizers(ctx context.Context, event_id int) ([]*models.Organizer, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetOrganizers", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	organizers, err := s.organizers.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting organizers",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful getting organizers",
+		slog.Int("event", event_id),
+	)
+	return organizers, nil
+}
+
+// AddOrganizer makes the user an organizer of the event with the role, or changes
+// the role of an organizer. Only the owner can add organizers, and the owner's own
+// role only changes by transferring the ownership.
+func (s *EventService) AddOrganizer(ctx context.Context, event_id int, user_id string, role string) (*models.Organizer, error) {
+	ctx, span := tracer.Start(ctx, "EventService.AddOrganizer", trace.WithAttributes(attribute.Int("event.id", event_id), attribute.String("user.id", user_id)))
+	defer span.End()
+
+	event, err := s.authorize(ctx, event_id, models.OrganizerOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validation.organizer(user_id, role); err != nil {
+		s.log(ctx).Info(
+			"Invalid organizer",
+			slog.String("user_id", user_id),
+			slog.String("role", role),
+		)
+		return nil, err
+	}
+	if strings.EqualFold(user_id, event.Creator) {
+		return nil, service.ErrOwnerRole
+	}
+
+	organizer := &models.Organizer{EventId: event_id, UserId: strings.ToLower(user_id), Role: role}
+	err = s.organizers.Add(ctx, organizer)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAlreadyExists) {
+			s.log(ctx).Info(
+				"User is the owner of the event",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return nil, service.ErrOwnerRole
+		}
+		s.log(ctx).Error(
+			"Error adding organizer",
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful added organizer",
+		slog.String("user_id", organizer.UserId),
+		slog.Int("event_id", event_id),
+		slog.String("role", role),
+	)
+	return organizer, nil
+}
+
+// RemoveOrganizer removes an organizer of the event. The owner removes any other
+// organizer and an organizer can always step down on their own.
+func (s *EventService) RemoveOrganizer(ctx context.Context, event_id int, user_id string) error {
+	ctx, span := tracer.Start(ctx, "EventService.RemoveOrganizer", trace.WithAttributes(attribute.Int("event.id", event_id), attribute.String("user.id", user_id)))
+	defer span.End()
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		s.log(ctx).Warn(
+			"Unauthenticated access to event",
+			slog.Int("id", event_id),
+		)
+		return service.ErrUnauthenticated
+	}
+	if !strings.EqualFold(caller.UserId, user_id) {
+		if _, err := s.authorize(ctx, event_id, models.OrganizerOwner); err != nil {
+			return err
+		}
+	}
+
+	err := s.organizers.Remove(ctx, event_id, user_id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Organizer not found",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrOrganizerNotFound
+		}
+		s.log(ctx).Error(
+			"Error removing organizer",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful removed organizer",
+		slog.String("user_id", user_id),
+		slog.Int("event_id", event_id),
+	)
+	return nil
+}
+
+// TransferOwnership makes the user the creator and owner of the event. The previous
+// owner stays an organizer of the event as an editor.
+func (s *EventService) TransferOwnership(ctx context.Context, event_id int, to string) error {
+	ctx, span := tracer.Start(ctx, "EventService.TransferOwnership", trace.WithAttributes(attribute.Int("event.id", event_id), attribute.String("to_user.id", to)))
+	defer span.End()
+
+	current, err := s.authorize(ctx, event_id, models.OrganizerOwner)
+	if err != nil {
+		return err
+	}
+	if _, err := uuid.Parse(to); err != nil {
+		s.log(ctx).Info(
+			"Invalid new owner",
+			slog.String("user_id", to),
+		)
+		return service.ErrInvalidOrganizer
+	}
+	to = strings.ToLower(to)
+	if strings.EqualFold(to, current.Creator) {
+		return nil
+	}
+
+	transferred := *current
+	transferred.Creator = to
+	_, err = s.organizers.TransferOwnership(ctx, event_id, to, entry(ctx, &models.EventHistory{
+		Action:   models.ActionTransfer,
+		Changes:  map[string]models.FieldChange{models.FieldCreator: {Old: current.Creator, New: to}},
+		Snapshot: &transferred,
+	}))
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Warn(
+				"Event not found",
+				slog.Int("id", event_id),
+			)
+			return service.ErrRecordNotFound
+		}
+		s.log(ctx).Error(
+			"Error transferring ownership",
+			slog.Int("id", event_id),
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.invalidate(ctx, event_id)
+	s.log(ctx).Info(
+		"Successful transferred ownership",
+		slog.Int("id", event_id),
+		slog.String("from", current.Creator),
+		slog.String("to", to),
+	)
+	return nil
+}
+
+// role returns the role of the caller among the organizers of the event: the owner
+// for its creator and for admins, and an empty role for anyone else.
+func (s *EventService) role(ctx context.Context, caller *auth.Caller, event *models.EventResponse) (string, error) {
+	if caller == nil {
+		return "", nil
+	}
+	if canManage(caller, event) {
+		return models.OrganizerOwner, nil
+	}
+	role, err := s.organizers.GetRole(ctx, event.Id, caller.UserId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			return "", nil
+		}
+		s.log(ctx).Error(
+			"Error getting organizer role",
+			slog.Int("event_id", event.Id),
+			slog.String("err", err.Error()),
+		)
+		return "", service.ErrRepositoryError
+	}
+	return role, nil
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/models"
+)
+
+// canManage reports whether the caller owns the event or is an admin.
+func canManage(caller *auth.Caller, event *models.EventResponse) bool {
+	if caller == nil || event == nil {
+		return false
+	}
+	return caller.IsAdmin() || strings.EqualFold(caller.UserId, event.Creator)
+}
+
+// viewer returns the user events are listed for, nil for admins who see all events.
+func viewer(ctx context.Context) *models.Viewer {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok {
+		return &models.Viewer{}
+	}
+	if caller.IsAdmin() {
+		return nil
+	}
+	return &models.Viewer{UserId: caller.UserId}
+}
+
+// ranks orders the organizer roles, a role is allowed everything a lower one is.
+var ranks = map[string]int{
+	models.OrganizerViewer:  1,
+	models.OrganizerCheckIn: 2,
+	models.OrganizerEditor:  3,
+	models.OrganizerOwner:   4,
+}
+
+// permits reports whether the granted role allows what the wanted role does.
+// An empty or unknown role allows nothing.
+func permits(granted string, want string) bool {
+	return ranks[granted] > 0 && ranks[granted] >= ranks[want]
+}
@@ -0,0 +1,84 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanManage(t *testing.T) {
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	other := "ea28ecf4-02b1-453d-965d-408253a874b9"
+	event := &models.EventResponse{Id: 1, Creator: creator}
+
+	tests := []struct {
+		name   string
+		caller *auth.Caller
+		event  *models.EventResponse
+		want   bool
+	}{
+		{
+			name:   "creator",
+			caller: &auth.Caller{UserId: creator},
+			event:  event,
+			want:   true,
+		},
+		{
+			name:   "creator in upper case",
+			caller: &auth.Caller{UserId: "EA27ECF4-02B1-453D-965D-408253A874B9"},
+			event:  event,
+			want:   true,
+		},
+		{
+			name:   "admin",
+			caller: &auth.Caller{UserId: other, Role: auth.RoleAdmin},
+			event:  event,
+			want:   true,
+		},
+		{
+			name:   "other user",
+			caller: &auth.Caller{UserId: other},
+			event:  event,
+			want:   false,
+		},
+		{
+			name:   "other user with unknown role",
+			caller: &auth.Caller{UserId: other, Role: "moderator"},
+			event:  event,
+			want:   false,
+		},
+		{
+			name:   "no caller",
+			caller: nil,
+			event:  event,
+			want:   false,
+		},
+		{
+			name:   "no event",
+			caller: &auth.Caller{UserId: creator},
+			event:  nil,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canManage(tt.caller, tt.event))
+		})
+	}
+}
+
+func TestPermits(t *testing.T) {
+	assert.True(t, permits(models.OrganizerOwner, models.OrganizerOwner))
+	assert.True(t, permits(models.OrganizerOwner, models.OrganizerViewer))
+	assert.True(t, permits(models.OrganizerEditor, models.OrganizerCheckIn))
+	assert.True(t, permits(models.OrganizerCheckIn, models.OrganizerViewer))
+	assert.True(t, permits(models.OrganizerViewer, models.OrganizerViewer))
+	assert.False(t, permits(models.OrganizerEditor, models.OrganizerOwner))
+	assert.False(t, permits(models.OrganizerCheckIn, models.OrganizerEditor))
+	assert.False(t, permits(models.OrganizerViewer, models.OrganizerCheckIn))
+	assert.False(t, permits("", models.OrganizerViewer))
+	assert.False(t, permits("moderator", models.OrganizerViewer))
+}
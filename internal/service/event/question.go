@@ -0,0 +1,168 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateQuestion adds a question to the registration form of the event.
+func (s *EventService) CreateQuestion(ctx context.Context, question *models.Question) (int, error) {
+	ctx, span := tracer.Start(ctx, "EventService.CreateQuestion", trace.WithAttributes(attribute.Int("event.id", question.EventId)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, question.EventId, models.OrganizerEditor); err != nil {
+		return 0, err
+	}
+	if err := s.validation.question(question); err != nil {
+		s.log(ctx).Info(
+			"Invalid question",
+			slog.String("err", err.Error()),
+		)
+		return 0, err
+	}
+
+	id, err := s.questions.Create(ctx, question)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error create question",
+			slog.String("err", err.Error()),
+		)
+		return 0, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful create question",
+		slog.Int("event_id", question.EventId),
+		slog.Int("id", id),
+	)
+	return id, nil
+}
+
+// GetQuestions returns the registration form of the event.
+func (s *EventService) GetQuestions(ctx context.Context, event_id int) ([]*models.Question, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetQuestions", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.GetById(ctx, event_id); err != nil {
+		return nil, err
+	}
+
+	questions, err := s.questions.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting questions",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful getting questions",
+		slog.Int("event", event_id),
+	)
+	return questions, nil
+}
+
+// DeleteQuestion removes a question from the registration form of the event,
+// the answers already given to it are kept.
+func (s *EventService) DeleteQuestion(ctx context.Context, event_id int, id int) error {
+	ctx, span := tracer.Start(ctx, "EventService.DeleteQuestion", trace.WithAttributes(attribute.Int("event.id", event_id), attribute.Int("question.id", id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerEditor); err != nil {
+		return err
+	}
+
+	err := s.questions.Delete(ctx, id, event_id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Question not found",
+				slog.Int("event_id", event_id),
+				slog.Int("id", id),
+			)
+			return service.ErrQuestionNotFound
+		}
+		s.log(ctx).Error(
+			"Error delete question",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful delete question",
+		slog.Int("event_id", event_id),
+		slog.Int("id", id),
+	)
+	return nil
+}
+
+// ExportAnswers returns the registration form of the event with the answers of
+// its active and pending registrations.
+func (s *EventService) ExportAnswers(ctx context.Context, event_id int) (*models.AnswerExport, error) {
+	ctx, span := tracer.Start(ctx, "EventService.ExportAnswers", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	questions, err := s.questions.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting questions",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	registrations, err := s.eventUserRepo.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting all users by event",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+
+	export := &models.AnswerExport{EventId: event_id, Questions: questions, Registrations: []*models.Registration{}}
+	for _, registration := range registrations {
+		if registration.Status == models.RegistrationActive || registration.Status == models.RegistrationPending {
+			export.Registrations = append(export.Registrations, registration)
+		}
+	}
+	s.log(ctx).Info(
+		"Successful export of answers",
+		slog.Int("event", event_id),
+	)
+	return export, nil
+}
+
+// checkAnswers checks the answers of a registration against the registration form of the event.
+func (s *EventService) checkAnswers(ctx context.Context, event_id int, answers []models.Answer) error {
+	questions, err := s.questions.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting questions",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	if err := s.validation.answers(questions, answers); err != nil {
+		s.log(ctx).Info(
+			"Invalid answers",
+			slog.Int("event_id", event_id),
+			slog.String("err", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Estriper0/EventService/internal/metrics"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/Estriper0/EventService/internal/ticket"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetTicket issues the signed ticket of an active registration, rendered as
+// a QR code PNG when qr is set.
+func (s *EventService) GetTicket(ctx context.Context, user_id string, event_id int, qr bool) (*models.Ticket, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetTicket", trace.WithAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if s.tickets == nil {
+		s.log(ctx).Warn(
+			"Ticket secret is not configured",
+		)
+		return nil, service.ErrTicketsDisabled
+	}
+	if err := s.authorizeRegistration(ctx, user_id, event_id, models.OrganizerCheckIn); err != nil {
+		return nil, err
+	}
+
+	registration, err := s.eventUserRepo.Get(ctx, user_id, event_id)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
+			"Error getting registration",
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	if registration == nil || registration.Status != models.RegistrationActive {
+		s.log(ctx).Info(
+			"User is not registered",
+			slog.String("user_id", user_id),
+			slog.Int("event_id", event_id),
+		)
+		return nil, service.ErrNotRegistered
+	}
+
+	t := &models.Ticket{
+		EventId: event_id,
+		UserId:  user_id,
+		Token:   s.tickets.Issue(event_id, user_id),
+	}
+	if qr {
+		t.QR, err = ticket.QR(t.Token, s.config.Tickets.QRSize)
+		if err != nil {
+			s.log(ctx).Error(
+				"Error rendering ticket QR code",
+				slog.String("err", err.Error()),
+			)
+			return nil, err
+		}
+	}
+	s.log(ctx).Info(
+		"Successful issuing ticket",
+		slog.String("user_id", user_id),
+		slog.Int("event_id", event_id),
+	)
+	return t, nil
+}
+
+// CheckIn verifies the ticket token and records the check-in of its registration.
+// A ticket can be checked in only once.
+func (s *EventService) CheckIn(ctx context.Context, token string) (*models.Registration, error) {
+	ctx, span := tracer.Start(ctx, "EventService.CheckIn")
+	defer span.End()
+
+	if s.tickets == nil {
+		s.log(ctx).Warn(
+			"Ticket secret is not configured",
+		)
+		return nil, service.ErrTicketsDisabled
+	}
+	event_id, user_id, err := s.tickets.Verify(token)
+	if err != nil {
+		s.log(ctx).Warn(
+			"Invalid ticket",
+		)
+		return nil, service.ErrInvalidTicket
+	}
+	span.SetAttributes(attribute.String("user.id", user_id), attribute.Int("event.id", event_id))
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerCheckIn); err != nil {
+		return nil, err
+	}
+
+	registration, err := s.eventUserRepo.Get(ctx, user_id, event_id)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
+			"Error getting registration",
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	if registration == nil || registration.Status != models.RegistrationActive {
+		s.log(ctx).Info(
+			"User is not registered",
+			slog.String("user_id", user_id),
+			slog.Int("event_id", event_id),
+		)
+		return nil, service.ErrNotRegistered
+	}
+	if registration.CheckedInAt != nil {
+		s.log(ctx).Info(
+			"Ticket is already checked in",
+			slog.String("user_id", user_id),
+			slog.Int("event_id", event_id),
+		)
+		return nil, service.ErrCheckedIn
+	}
+
+	checkedInAt, err := s.eventUserRepo.CheckIn(ctx, user_id, event_id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"Ticket is already checked in",
+				slog.String("user_id", user_id),
+				slog.Int("event_id", event_id),
+			)
+			return nil, service.ErrCheckedIn
+		}
+		s.log(ctx).Error(
+			"Error checking in ticket",
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	registration.CheckedInAt = &checkedInAt
+	metrics.CheckedIn()
+	s.log(ctx).Info(
+		"Successful check-in",
+		slog.String("user_id", user_id),
+		slog.Int("event_id", event_id),
+	)
+	return registration, nil
+}
+
+// GetAttendance returns the number of registered and checked in users of the event.
+func (s *EventService) GetAttendance(ctx context.Context, event_id int) (*models.Attendance, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetAttendance", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, event_id, models.OrganizerViewer); err != nil {
+		return nil, err
+	}
+
+	attendance, err := s.eventUserRepo.GetAttendance(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting attendance",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful getting attendance",
+		slog.Int("event", event_id),
+	)
+	return attendance, nil
+}
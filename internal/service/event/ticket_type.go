@@ -0,0 +1,141 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Estriper0/EventService/internal/auth"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *EventService) CreateTicketType(ctx context.Context, ticketType *models.TicketType) (int, error) {
+	ctx, span := tracer.Start(ctx, "EventService.CreateTicketType", trace.WithAttributes(attribute.Int("event.id", ticketType.EventId)))
+	defer span.End()
+
+	if _, err := s.authorize(ctx, ticketType.EventId, models.OrganizerEditor); err != nil {
+		return 0, err
+	}
+	if err := s.validation.ticketType(ticketType); err != nil {
+		s.log(ctx).Info(
+			"Invalid ticket type",
+			slog.String("err", err.Error()),
+		)
+		return 0, err
+	}
+
+	id, err := s.ticketTypes.Create(ctx, ticketType)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAlreadyExists) {
+			s.log(ctx).Info(
+				"Ticket type already exists",
+				slog.Int("event_id", ticketType.EventId),
+				slog.String("name", ticketType.Name),
+			)
+			return 0, service.ErrTicketTypeExists
+		}
+		s.log(ctx).Error(
+			"Error create ticket type",
+			slog.String("err", err.Error()),
+		)
+		return 0, service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful create ticket type",
+		slog.Int("event_id", ticketType.EventId),
+		slog.Int("id", id),
+	)
+	return id, nil
+}
+
+// GetTicketTypes returns the ticket types of the event. Hidden ticket types are
+// only returned to its organizers.
+func (s *EventService) GetTicketTypes(ctx context.Context, event_id int) ([]*models.TicketType, error) {
+	ctx, span := tracer.Start(ctx, "EventService.GetTicketTypes", trace.WithAttributes(attribute.Int("event.id", event_id)))
+	defer span.End()
+
+	event, err := s.GetById(ctx, event_id)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketTypes, err := s.ticketTypes.GetAllByEvent(ctx, event_id)
+	if err != nil {
+		s.log(ctx).Error(
+			"Error getting ticket types",
+			slog.Int("event", event_id),
+			slog.String("err", err.Error()),
+		)
+		return nil, service.ErrRepositoryError
+	}
+
+	caller, _ := auth.CallerFromContext(ctx)
+	role, err := s.role(ctx, caller, event)
+	if err != nil {
+		return nil, err
+	}
+	if !permits(role, models.OrganizerViewer) {
+		visible := []*models.TicketType{}
+		for _, ticketType := range ticketTypes {
+			if !ticketType.Hidden {
+				visible = append(visible, ticketType)
+			}
+		}
+		ticketTypes = visible
+	}
+	s.log(ctx).Info(
+		"Successful getting ticket types",
+		slog.Int("event", event_id),
+	)
+	return ticketTypes, nil
+}
+
+// checkTicketType checks that the ticket type chosen for a registration belongs
+// to the event and is on sale, and that one is chosen when the event has any.
+func (s *EventService) checkTicketType(ctx context.Context, event_id int, ticket_type_id int) error {
+	if ticket_type_id == 0 {
+		ticketTypes, err := s.ticketTypes.GetAllByEvent(ctx, event_id)
+		if err != nil {
+			s.log(ctx).Error(
+				"Error getting ticket types",
+				slog.Int("event", event_id),
+				slog.String("err", err.Error()),
+			)
+			return service.ErrRepositoryError
+		}
+		if len(ticketTypes) > 0 {
+			return service.ErrTicketTypeRequired
+		}
+		return nil
+	}
+
+	ticketType, err := s.ticketTypes.GetById(ctx, ticket_type_id)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
+			"Error getting ticket type",
+			slog.Int("id", ticket_type_id),
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	if ticketType == nil || ticketType.EventId != event_id {
+		s.log(ctx).Info(
+			"Ticket type not found",
+			slog.Int("id", ticket_type_id),
+			slog.Int("event_id", event_id),
+		)
+		return service.ErrTicketTypeNotFound
+	}
+	if !ticketType.OnSale(s.validation.now()) {
+		s.log(ctx).Info(
+			"Ticket type is not on sale",
+			slog.Int("id", ticket_type_id),
+		)
+		return service.ErrSaleClosed
+	}
+	return nil
+}
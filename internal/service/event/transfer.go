@@ -0,0 +1,111 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TransferRegistration hands the active registration of from over to the user to,
+// with its ticket type and guests. The seats stay taken, so nobody else can get
+// them in between. The event must allow transfers and must not have started,
+// and a checked in registration cannot be transferred. The registration of a private
+// event can only go to an invited user, unless an editor of the event transfers it.
+func (s *EventService) TransferRegistration(ctx context.Context, event_id int, from string, to string) error {
+	ctx, span := tracer.Start(ctx, "EventService.TransferRegistration", trace.WithAttributes(attribute.Int("event.id", event_id), attribute.String("user.id", from), attribute.String("to_user.id", to)))
+	defer span.End()
+
+	if err := s.authorizeRegistration(ctx, from, event_id, models.OrganizerEditor); err != nil {
+		return err
+	}
+	if _, err := uuid.Parse(to); err != nil {
+		s.log(ctx).Info(
+			"Invalid transfer recipient",
+			slog.String("user_id", to),
+		)
+		return service.ErrInvalidRecipient
+	}
+	if strings.EqualFold(from, to) {
+		return service.ErrTransferToSelf
+	}
+
+	event, err := s.get(ctx, event_id)
+	if err != nil {
+		return err
+	}
+	if err := s.validation.transfer(event); err != nil {
+		s.log(ctx).Info(
+			"Transfer is not allowed",
+			slog.Int("event_id", event_id),
+			slog.String("err", err.Error()),
+		)
+		return err
+	}
+	if err := s.checkRecipient(ctx, event, to); err != nil {
+		return err
+	}
+
+	registration, err := s.eventUserRepo.Get(ctx, from, event_id)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		s.log(ctx).Error(
+			"Error getting registration",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	if registration == nil || registration.Status != models.RegistrationActive {
+		s.log(ctx).Info(
+			"User is not registered",
+			slog.String("user_id", from),
+			slog.Int("event_id", event_id),
+		)
+		return service.ErrNotRegistered
+	}
+	if registration.CheckedInAt != nil {
+		s.log(ctx).Info(
+			"Ticket is already checked in",
+			slog.String("user_id", from),
+			slog.Int("event_id", event_id),
+		)
+		return service.ErrCheckedIn
+	}
+
+	err = s.eventUserRepo.Transfer(ctx, event_id, from, to)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			s.log(ctx).Info(
+				"User is not registered",
+				slog.String("user_id", from),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrNotRegistered
+		} else if errors.Is(err, repositories.ErrAlreadyExists) {
+			s.log(ctx).Info(
+				"User is already registered",
+				slog.String("user_id", to),
+				slog.Int("event_id", event_id),
+			)
+			return service.ErrRegistered
+		}
+		s.log(ctx).Error(
+			"Error transferring registration",
+			slog.String("err", err.Error()),
+		)
+		return service.ErrRepositoryError
+	}
+	s.log(ctx).Info(
+		"Successful transferred registration",
+		slog.String("from", from),
+		slog.String("to", to),
+		slog.Int("event_id", event_id),
+	)
+	return nil
+}
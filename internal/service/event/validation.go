@@ -0,0 +1,232 @@
+package event
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/google/uuid"
+)
+
+// validation checks the domain rules of an event that cannot be expressed
+// with struct tags because they depend on the clock or on the stored event.
+type validation struct {
+	now      func() time.Time
+	defaults *config.Registration
+}
+
+func newValidation(defaults *config.Registration) *validation {
+	return &validation{now: time.Now, defaults: defaults}
+}
+
+func (v *validation) create(event *models.EventCreateRequest) error {
+	if !event.StartDate.After(v.now()) {
+		return service.ErrStartDateInPast
+	}
+	if strings.TrimSpace(event.Location) == "" {
+		return service.ErrLocationRequired
+	}
+	return window(event.RegistrationOpensAt, event.RegistrationClosesAt, event.CancellationCutoff)
+}
+
+// update checks the updated fields against the current state of the event.
+// The start date is only checked when it changes, so an event that has
+// already started can still be edited.
+func (v *validation) update(event *models.EventUpdateRequest, current *models.EventResponse) error {
+	if event.Has(models.FieldStartDate) && !event.StartDate.Equal(current.StartDate) && !event.StartDate.After(v.now()) {
+		return service.ErrStartDateInPast
+	}
+	if event.Has(models.FieldLocation) && strings.TrimSpace(event.Location) == "" {
+		return service.ErrLocationRequired
+	}
+	if event.Has(models.FieldMaxAttendees) && event.MaxAttendees < current.CurrentAttendance {
+		return service.ErrCapacityBelowAttendance
+	}
+	opens, closes := current.RegistrationOpensAt, current.RegistrationClosesAt
+	if event.Has(models.FieldRegistrationOpens) && event.RegistrationOpensAt != nil {
+		opens = event.RegistrationOpensAt
+	}
+	if event.Has(models.FieldRegistrationCloses) && event.RegistrationClosesAt != nil {
+		closes = event.RegistrationClosesAt
+	}
+	return window(opens, closes, event.CancellationCutoff)
+}
+
+func window(opens *time.Time, closes *time.Time, cutoff *time.Duration) error {
+	if opens != nil && closes != nil && !closes.After(*opens) {
+		return service.ErrRegistrationWindow
+	}
+	if cutoff != nil && *cutoff < 0 {
+		return service.ErrCancellationCutoff
+	}
+	return nil
+}
+
+// register checks that the registration window of the event is open. Without its
+// own window an event is open until the configured time before its start.
+func (v *validation) register(event *models.EventResponse) error {
+	now := v.now()
+	if event.RegistrationOpensAt != nil && now.Before(*event.RegistrationOpensAt) {
+		return service.ErrRegistrationNotOpen
+	}
+	closes := event.StartDate.Add(-v.defaults.ClosesBeforeStart)
+	if event.RegistrationClosesAt != nil {
+		closes = *event.RegistrationClosesAt
+	}
+	if !now.Before(closes) {
+		return service.ErrRegistrationClosed
+	}
+	return nil
+}
+
+// cancel checks that a registration for the event can still be cancelled.
+func (v *validation) cancel(event *models.EventResponse) error {
+	cutoff := v.defaults.CancellationCutoff
+	if event.CancellationCutoff != nil {
+		cutoff = *event.CancellationCutoff
+	}
+	if !v.now().Before(event.StartDate.Add(-cutoff)) {
+		return service.ErrCancellationClosed
+	}
+	return nil
+}
+
+// transfer checks that the event lets registrations be transferred, which is
+// only possible before it starts.
+func (v *validation) transfer(event *models.EventResponse) error {
+	if !event.AllowTransfers {
+		return service.ErrTransferNotAllowed
+	}
+	if !v.now().Before(event.StartDate) {
+		return service.ErrTransferClosed
+	}
+	return nil
+}
+
+// maxAnswerLength is the maximum length in characters of an answer to a text question.
+const maxAnswerLength = 1000
+
+func (v *validation) question(question *models.Question) error {
+	if strings.TrimSpace(question.Label) == "" {
+		return service.ErrQuestionLabelRequired
+	}
+	switch question.Type {
+	case models.QuestionSingleChoice, models.QuestionMultiChoice:
+		if len(question.Options) < 2 {
+			return service.ErrQuestionOptions
+		}
+		seen := make(map[string]bool, len(question.Options))
+		for _, option := range question.Options {
+			if strings.TrimSpace(option) == "" || seen[option] {
+				return service.ErrQuestionOptions
+			}
+			seen[option] = true
+		}
+	case models.QuestionText, models.QuestionBoolean:
+		if len(question.Options) > 0 {
+			return service.ErrQuestionOptions
+		}
+	default:
+		return service.ErrQuestionType
+	}
+	return nil
+}
+
+// answers checks the answers of a registration against the registration form:
+// every answer must match a question of the form by its type and options, and
+// every required question must be answered.
+func (v *validation) answers(questions []*models.Question, answers []models.Answer) error {
+	byId := make(map[int]*models.Question, len(questions))
+	for _, question := range questions {
+		byId[question.Id] = question
+	}
+	answered := make(map[int]bool, len(answers))
+	for _, answer := range answers {
+		question, ok := byId[answer.QuestionId]
+		if !ok || answered[answer.QuestionId] || !valid(question, answer.Values) {
+			return service.ErrInvalidAnswer
+		}
+		answered[answer.QuestionId] = true
+	}
+	for _, question := range questions {
+		if question.Required && !answered[question.Id] {
+			return service.ErrAnswerRequired
+		}
+	}
+	return nil
+}
+
+func valid(question *models.Question, values []string) bool {
+	switch question.Type {
+	case models.QuestionText:
+		return len(values) == 1 && strings.TrimSpace(values[0]) != "" && utf8.RuneCountInString(values[0]) <= maxAnswerLength
+	case models.QuestionBoolean:
+		return len(values) == 1 && (values[0] == "true" || values[0] == "false")
+	case models.QuestionSingleChoice:
+		return len(values) == 1 && slices.Contains(question.Options, values[0])
+	case models.QuestionMultiChoice:
+		if len(values) == 0 {
+			return false
+		}
+		seen := make(map[string]bool, len(values))
+		for _, value := range values {
+			if seen[value] || !slices.Contains(question.Options, value) {
+				return false
+			}
+			seen[value] = true
+		}
+		return true
+	}
+	return false
+}
+
+// group checks the number of users of a group registration.
+func (v *validation) group(user_ids []string) error {
+	if len(user_ids) == 0 {
+		return service.ErrGroupEmpty
+	}
+	if v.defaults.MaxGroupSize > 0 && len(user_ids) > v.defaults.MaxGroupSize {
+		return service.ErrGroupTooLarge
+	}
+	return nil
+}
+
+// organizer checks the user and the role of an organizer added to an event.
+func (v *validation) organizer(user_id string, role string) error {
+	if _, err := uuid.Parse(user_id); err != nil {
+		return service.ErrInvalidOrganizer
+	}
+	switch role {
+	case models.OrganizerEditor, models.OrganizerCheckIn, models.OrganizerViewer:
+		return nil
+	}
+	return service.ErrOrganizerRole
+}
+
+// guests checks the guests of a registration against the cap of the event.
+func (v *validation) guests(event *models.EventResponse, guests int) error {
+	if guests < 0 {
+		return service.ErrGuestsNegative
+	}
+	if guests > event.MaxGuests {
+		return service.ErrTooManyGuests
+	}
+	return nil
+}
+
+func (v *validation) ticketType(ticketType *models.TicketType) error {
+	if strings.TrimSpace(ticketType.Name) == "" {
+		return service.ErrTicketTypeNameRequired
+	}
+	if ticketType.Capacity <= 0 {
+		return service.ErrTicketTypeCapacity
+	}
+	if ticketType.SaleStartsAt != nil && ticketType.SaleEndsAt != nil && !ticketType.SaleEndsAt.After(*ticketType.SaleStartsAt) {
+		return service.ErrSaleWindow
+	}
+	return nil
+}
@@ -0,0 +1,473 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidation_Create(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	v := &validation{now: func() time.Time { return now }}
+	later := now.Add(time.Hour)
+	negative := -time.Minute
+
+	tests := []struct {
+		name    string
+		event   *models.EventCreateRequest
+		wantErr error
+	}{
+		{
+			name:    "valid",
+			event:   &models.EventCreateRequest{StartDate: now.Add(time.Hour), Location: "Zoom"},
+			wantErr: nil,
+		},
+		{
+			name:    "start date in the past",
+			event:   &models.EventCreateRequest{StartDate: now.Add(-time.Hour), Location: "Zoom"},
+			wantErr: service.ErrStartDateInPast,
+		},
+		{
+			name:    "start date now",
+			event:   &models.EventCreateRequest{StartDate: now, Location: "Zoom"},
+			wantErr: service.ErrStartDateInPast,
+		},
+		{
+			name:    "blank location",
+			event:   &models.EventCreateRequest{StartDate: now.Add(time.Hour), Location: "  "},
+			wantErr: service.ErrLocationRequired,
+		},
+		{
+			name:    "registration closes before it opens",
+			event:   &models.EventCreateRequest{StartDate: now.Add(time.Hour), Location: "Zoom", RegistrationOpensAt: &later, RegistrationClosesAt: &now},
+			wantErr: service.ErrRegistrationWindow,
+		},
+		{
+			name:    "negative cancellation cut-off",
+			event:   &models.EventCreateRequest{StartDate: now.Add(time.Hour), Location: "Zoom", CancellationCutoff: &negative},
+			wantErr: service.ErrCancellationCutoff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.create(tt.event)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, service.ErrInvalidArgument)
+		})
+	}
+}
+
+func TestValidation_Update(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	v := &validation{now: func() time.Time { return now }}
+	current := &models.EventResponse{
+		Id:                1,
+		StartDate:         now.Add(-time.Hour),
+		Location:          "Zoom",
+		MaxAttendees:      20,
+		CurrentAttendance: 10,
+	}
+
+	tests := []struct {
+		name     string
+		event    *models.EventUpdateRequest
+		wantErr  error
+		wantKind error
+	}{
+		{
+			name:    "valid",
+			event:   &models.EventUpdateRequest{Id: 1, StartDate: now.Add(time.Hour), Location: "Office", MaxAttendees: 10},
+			wantErr: nil,
+		},
+		{
+			name:    "unchanged start date in the past",
+			event:   &models.EventUpdateRequest{Id: 1, StartDate: current.StartDate, Location: "Zoom", MaxAttendees: 20},
+			wantErr: nil,
+		},
+		{
+			name:     "start date moved to the past",
+			event:    &models.EventUpdateRequest{Id: 1, StartDate: now.Add(-2 * time.Hour), Location: "Zoom", MaxAttendees: 20},
+			wantErr:  service.ErrStartDateInPast,
+			wantKind: service.ErrInvalidArgument,
+		},
+		{
+			name:     "location omitted",
+			event:    &models.EventUpdateRequest{Id: 1, StartDate: current.StartDate, MaxAttendees: 20},
+			wantErr:  service.ErrLocationRequired,
+			wantKind: service.ErrInvalidArgument,
+		},
+		{
+			name:     "max attendees below current attendance",
+			event:    &models.EventUpdateRequest{Id: 1, StartDate: current.StartDate, Location: "Zoom", MaxAttendees: 9},
+			wantErr:  service.ErrCapacityBelowAttendance,
+			wantKind: service.ErrFailedPrecondition,
+		},
+		{
+			name:    "fields outside the mask are not checked",
+			event:   &models.EventUpdateRequest{Id: 1, Title: "New title", Fields: []string{models.FieldTitle}},
+			wantErr: nil,
+		},
+		{
+			name:     "masked field is checked",
+			event:    &models.EventUpdateRequest{Id: 1, Location: "", Fields: []string{models.FieldTitle, models.FieldLocation}},
+			wantErr:  service.ErrLocationRequired,
+			wantKind: service.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.update(tt.event, current)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, tt.wantKind)
+		})
+	}
+}
+
+func TestValidation_TicketType(t *testing.T) {
+	v := newValidation(&config.Registration{})
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		ticketType *models.TicketType
+		wantErr    error
+	}{
+		{
+			name:       "valid",
+			ticketType: &models.TicketType{Name: "VIP", Capacity: 10, SaleStartsAt: &start, SaleEndsAt: &end},
+			wantErr:    nil,
+		},
+		{
+			name:       "open sale window",
+			ticketType: &models.TicketType{Name: "General", Capacity: 100},
+			wantErr:    nil,
+		},
+		{
+			name:       "blank name",
+			ticketType: &models.TicketType{Name: " ", Capacity: 10},
+			wantErr:    service.ErrTicketTypeNameRequired,
+		},
+		{
+			name:       "zero capacity",
+			ticketType: &models.TicketType{Name: "VIP"},
+			wantErr:    service.ErrTicketTypeCapacity,
+		},
+		{
+			name:       "sale ends before it starts",
+			ticketType: &models.TicketType{Name: "VIP", Capacity: 10, SaleStartsAt: &end, SaleEndsAt: &start},
+			wantErr:    service.ErrSaleWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ticketType(tt.ticketType)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, service.ErrInvalidArgument)
+		})
+	}
+}
+
+func TestValidation_Register(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	v := &validation{now: func() time.Time { return now }, defaults: &config.Registration{ClosesBeforeStart: time.Hour}}
+	before := now.Add(-time.Minute)
+	after := now.Add(time.Minute)
+
+	tests := []struct {
+		name    string
+		event   *models.EventResponse
+		wantErr error
+	}{
+		{
+			name:    "open by default",
+			event:   &models.EventResponse{StartDate: now.Add(2 * time.Hour)},
+			wantErr: nil,
+		},
+		{
+			name:    "closed by default before start",
+			event:   &models.EventResponse{StartDate: now.Add(30 * time.Minute)},
+			wantErr: service.ErrRegistrationClosed,
+		},
+		{
+			name:    "own window is open",
+			event:   &models.EventResponse{StartDate: now.Add(30 * time.Minute), RegistrationOpensAt: &before, RegistrationClosesAt: &after},
+			wantErr: nil,
+		},
+		{
+			name:    "not open yet",
+			event:   &models.EventResponse{StartDate: now.Add(2 * time.Hour), RegistrationOpensAt: &after},
+			wantErr: service.ErrRegistrationNotOpen,
+		},
+		{
+			name:    "closed",
+			event:   &models.EventResponse{StartDate: now.Add(2 * time.Hour), RegistrationClosesAt: &before},
+			wantErr: service.ErrRegistrationClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.register(tt.event)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, service.ErrFailedPrecondition)
+		})
+	}
+}
+
+func TestValidation_Cancel(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	v := &validation{now: func() time.Time { return now }, defaults: &config.Registration{CancellationCutoff: 2 * time.Hour}}
+	none := time.Duration(0)
+
+	tests := []struct {
+		name    string
+		event   *models.EventResponse
+		wantErr error
+	}{
+		{
+			name:    "before default cut-off",
+			event:   &models.EventResponse{StartDate: now.Add(3 * time.Hour)},
+			wantErr: nil,
+		},
+		{
+			name:    "after default cut-off",
+			event:   &models.EventResponse{StartDate: now.Add(time.Hour)},
+			wantErr: service.ErrCancellationClosed,
+		},
+		{
+			name:    "event without cut-off",
+			event:   &models.EventResponse{StartDate: now.Add(time.Hour), CancellationCutoff: &none},
+			wantErr: nil,
+		},
+		{
+			name:    "event already started",
+			event:   &models.EventResponse{StartDate: now.Add(-time.Hour), CancellationCutoff: &none},
+			wantErr: service.ErrCancellationClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.cancel(tt.event)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, service.ErrFailedPrecondition)
+		})
+	}
+}
+
+func TestValidation_Guests(t *testing.T) {
+	v := newValidation(&config.Registration{})
+	event := &models.EventResponse{MaxGuests: 2}
+
+	assert.NoError(t, v.guests(event, 0))
+	assert.NoError(t, v.guests(event, 2))
+	assert.ErrorIs(t, v.guests(event, 3), service.ErrTooManyGuests)
+	assert.ErrorIs(t, v.guests(event, -1), service.ErrGuestsNegative)
+	assert.ErrorIs(t, v.guests(&models.EventResponse{}, 1), service.ErrTooManyGuests)
+}
+
+func TestValidation_Group(t *testing.T) {
+	v := newValidation(&config.Registration{MaxGroupSize: 2})
+
+	assert.NoError(t, v.group([]string{"user1", "user2"}))
+	assert.ErrorIs(t, v.group(nil), service.ErrGroupEmpty)
+	assert.ErrorIs(t, v.group([]string{"user1", "user2", "user3"}), service.ErrGroupTooLarge)
+	assert.NoError(t, newValidation(&config.Registration{}).group([]string{"user1", "user2", "user3"}))
+}
+
+func TestValidation_Organizer(t *testing.T) {
+	v := newValidation(&config.Registration{})
+	user := "ea27ecf4-02b1-453d-965d-408253a874b9"
+
+	assert.NoError(t, v.organizer(user, models.OrganizerEditor))
+	assert.NoError(t, v.organizer(user, models.OrganizerCheckIn))
+	assert.NoError(t, v.organizer(user, models.OrganizerViewer))
+	assert.ErrorIs(t, v.organizer(user, models.OrganizerOwner), service.ErrOrganizerRole)
+	assert.ErrorIs(t, v.organizer(user, "moderator"), service.ErrOrganizerRole)
+	assert.ErrorIs(t, v.organizer("user1", models.OrganizerViewer), service.ErrInvalidOrganizer)
+}
+
+func TestValidation_Transfer(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	v := &validation{now: func() time.Time { return now }, defaults: &config.Registration{}}
+
+	assert.NoError(t, v.transfer(&models.EventResponse{StartDate: now.Add(time.Minute), AllowTransfers: true}))
+	assert.ErrorIs(t, v.transfer(&models.EventResponse{StartDate: now.Add(time.Minute)}), service.ErrTransferNotAllowed)
+	assert.ErrorIs(t, v.transfer(&models.EventResponse{StartDate: now, AllowTransfers: true}), service.ErrTransferClosed)
+}
+
+func TestValidation_Question(t *testing.T) {
+	v := newValidation(&config.Registration{})
+
+	tests := []struct {
+		name     string
+		question *models.Question
+		wantErr  error
+	}{
+		{
+			name:     "text",
+			question: &models.Question{Label: "Dietary requirements", Type: models.QuestionText},
+			wantErr:  nil,
+		},
+		{
+			name:     "single choice",
+			question: &models.Question{Label: "T-shirt size", Type: models.QuestionSingleChoice, Options: []string{"S", "M", "L"}},
+			wantErr:  nil,
+		},
+		{
+			name:     "blank label",
+			question: &models.Question{Label: " ", Type: models.QuestionBoolean},
+			wantErr:  service.ErrQuestionLabelRequired,
+		},
+		{
+			name:     "unknown type",
+			question: &models.Question{Label: "Age", Type: "number"},
+			wantErr:  service.ErrQuestionType,
+		},
+		{
+			name:     "choice with one option",
+			question: &models.Question{Label: "Track", Type: models.QuestionMultiChoice, Options: []string{"Go"}},
+			wantErr:  service.ErrQuestionOptions,
+		},
+		{
+			name:     "duplicate options",
+			question: &models.Question{Label: "Track", Type: models.QuestionMultiChoice, Options: []string{"Go", "Go"}},
+			wantErr:  service.ErrQuestionOptions,
+		},
+		{
+			name:     "boolean with options",
+			question: &models.Question{Label: "Vegan", Type: models.QuestionBoolean, Options: []string{"yes", "no"}},
+			wantErr:  service.ErrQuestionOptions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.question(tt.question)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, service.ErrInvalidArgument)
+		})
+	}
+}
+
+func TestValidation_Answers(t *testing.T) {
+	v := newValidation(&config.Registration{})
+	questions := []*models.Question{
+		{Id: 1, Type: models.QuestionText, Required: true},
+		{Id: 2, Type: models.QuestionSingleChoice, Options: []string{"S", "M", "L"}},
+		{Id: 3, Type: models.QuestionMultiChoice, Options: []string{"Go", "Rust", "Zig"}},
+		{Id: 4, Type: models.QuestionBoolean},
+	}
+
+	tests := []struct {
+		name    string
+		answers []models.Answer
+		wantErr error
+	}{
+		{
+			name: "all answered",
+			answers: []models.Answer{
+				{QuestionId: 1, Values: []string{"No nuts"}},
+				{QuestionId: 2, Values: []string{"M"}},
+				{QuestionId: 3, Values: []string{"Go", "Zig"}},
+				{QuestionId: 4, Values: []string{"true"}},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "only required",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{"None"}}},
+			wantErr: nil,
+		},
+		{
+			name:    "required missing",
+			answers: []models.Answer{{QuestionId: 2, Values: []string{"M"}}},
+			wantErr: service.ErrAnswerRequired,
+		},
+		{
+			name:    "blank text",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{" "}}},
+			wantErr: service.ErrInvalidAnswer,
+		},
+		{
+			name:    "unknown option",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{"None"}}, {QuestionId: 2, Values: []string{"XL"}}},
+			wantErr: service.ErrInvalidAnswer,
+		},
+		{
+			name:    "several options of single choice",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{"None"}}, {QuestionId: 2, Values: []string{"S", "M"}}},
+			wantErr: service.ErrInvalidAnswer,
+		},
+		{
+			name:    "duplicate choices",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{"None"}}, {QuestionId: 3, Values: []string{"Go", "Go"}}},
+			wantErr: service.ErrInvalidAnswer,
+		},
+		{
+			name:    "not a boolean",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{"None"}}, {QuestionId: 4, Values: []string{"yes"}}},
+			wantErr: service.ErrInvalidAnswer,
+		},
+		{
+			name:    "unknown question",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{"None"}}, {QuestionId: 5, Values: []string{"x"}}},
+			wantErr: service.ErrInvalidAnswer,
+		},
+		{
+			name:    "answered twice",
+			answers: []models.Answer{{QuestionId: 1, Values: []string{"None"}}, {QuestionId: 1, Values: []string{"None"}}},
+			wantErr: service.ErrInvalidAnswer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.answers(questions, tt.answers)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
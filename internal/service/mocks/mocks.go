@@ -9,7 +9,6 @@ import (
 	reflect "reflect"
 
 	models "github.com/Estriper0/EventService/internal/models"
-	event "github.com/Estriper0/protobuf/gen/event"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,6 +35,35 @@ func (m *MockIEventService) EXPECT() *MockIEventServiceMockRecorder {
 	return m.recorder
 }
 
+// AddOrganizer mocks base method.
+func (m *MockIEventService) AddOrganizer(ctx context.Context, event_id int, user_id, role string) (*models.Organizer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrganizer", ctx, event_id, user_id, role)
+	ret0, _ := ret[0].(*models.Organizer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrganizer indicates an expected call of AddOrganizer.
+func (mr *MockIEventServiceMockRecorder) AddOrganizer(ctx, event_id, user_id, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrganizer", reflect.TypeOf((*MockIEventService)(nil).AddOrganizer), ctx, event_id, user_id, role)
+}
+
+// Approve mocks base method.
+func (m *MockIEventService) Approve(ctx context.Context, user_id string, event_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, user_id, event_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockIEventServiceMockRecorder) Approve(ctx, user_id, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockIEventService)(nil).Approve), ctx, user_id, event_id)
+}
+
 // CancellRegister mocks base method.
 func (m *MockIEventService) CancellRegister(ctx context.Context, user_id string, event_id int) error {
 	m.ctrl.T.Helper()
@@ -50,6 +78,35 @@ func (mr *MockIEventServiceMockRecorder) CancellRegister(ctx, user_id, event_id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancellRegister", reflect.TypeOf((*MockIEventService)(nil).CancellRegister), ctx, user_id, event_id)
 }
 
+// ChangeGuests mocks base method.
+func (m *MockIEventService) ChangeGuests(ctx context.Context, user_id string, event_id, guests int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeGuests", ctx, user_id, event_id, guests)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeGuests indicates an expected call of ChangeGuests.
+func (mr *MockIEventServiceMockRecorder) ChangeGuests(ctx, user_id, event_id, guests interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeGuests", reflect.TypeOf((*MockIEventService)(nil).ChangeGuests), ctx, user_id, event_id, guests)
+}
+
+// CheckIn mocks base method.
+func (m *MockIEventService) CheckIn(ctx context.Context, token string) (*models.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, token)
+	ret0, _ := ret[0].(*models.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockIEventServiceMockRecorder) CheckIn(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockIEventService)(nil).CheckIn), ctx, token)
+}
+
 // Create mocks base method.
 func (m *MockIEventService) Create(ctx context.Context, event *models.EventCreateRequest) (int, error) {
 	m.ctrl.T.Helper()
@@ -65,18 +122,92 @@ func (mr *MockIEventServiceMockRecorder) Create(ctx, event interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIEventService)(nil).Create), ctx, event)
 }
 
+// CreateInvite mocks base method.
+func (m *MockIEventService) CreateInvite(ctx context.Context, event_id int, user_id string) (*models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, event_id, user_id)
+	ret0, _ := ret[0].(*models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockIEventServiceMockRecorder) CreateInvite(ctx, event_id, user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockIEventService)(nil).CreateInvite), ctx, event_id, user_id)
+}
+
+// CreateQuestion mocks base method.
+func (m *MockIEventService) CreateQuestion(ctx context.Context, question *models.Question) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, question)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockIEventServiceMockRecorder) CreateQuestion(ctx, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockIEventService)(nil).CreateQuestion), ctx, question)
+}
+
+// CreateTicketType mocks base method.
+func (m *MockIEventService) CreateTicketType(ctx context.Context, ticketType *models.TicketType) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketType", ctx, ticketType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketType indicates an expected call of CreateTicketType.
+func (mr *MockIEventServiceMockRecorder) CreateTicketType(ctx, ticketType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketType", reflect.TypeOf((*MockIEventService)(nil).CreateTicketType), ctx, ticketType)
+}
+
 // DeleteById mocks base method.
-func (m *MockIEventService) DeleteById(ctx context.Context, id int) error {
+func (m *MockIEventService) DeleteById(ctx context.Context, id, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteById", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteById", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteById indicates an expected call of DeleteById.
-func (mr *MockIEventServiceMockRecorder) DeleteById(ctx, id interface{}) *gomock.Call {
+func (mr *MockIEventServiceMockRecorder) DeleteById(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIEventService)(nil).DeleteById), ctx, id, version)
+}
+
+// DeleteQuestion mocks base method.
+func (m *MockIEventService) DeleteQuestion(ctx context.Context, event_id, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", ctx, event_id, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockIEventServiceMockRecorder) DeleteQuestion(ctx, event_id, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteById", reflect.TypeOf((*MockIEventService)(nil).DeleteById), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockIEventService)(nil).DeleteQuestion), ctx, event_id, id)
+}
+
+// ExportAnswers mocks base method.
+func (m *MockIEventService) ExportAnswers(ctx context.Context, event_id int) (*models.AnswerExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAnswers", ctx, event_id)
+	ret0, _ := ret[0].(*models.AnswerExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportAnswers indicates an expected call of ExportAnswers.
+func (mr *MockIEventServiceMockRecorder) ExportAnswers(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAnswers", reflect.TypeOf((*MockIEventService)(nil).ExportAnswers), ctx, event_id)
 }
 
 // GetAll mocks base method.
@@ -95,18 +226,18 @@ func (mr *MockIEventServiceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
 }
 
 // GetAllByCreator mocks base method.
-func (m *MockIEventService) GetAllByCreator(ctx context.Context, creator string) ([]*models.EventResponse, error) {
+func (m *MockIEventService) GetAllByCreator(ctx context.Context, creator string, organized bool) ([]*models.EventResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllByCreator", ctx, creator)
+	ret := m.ctrl.Call(m, "GetAllByCreator", ctx, creator, organized)
 	ret0, _ := ret[0].([]*models.EventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllByCreator indicates an expected call of GetAllByCreator.
-func (mr *MockIEventServiceMockRecorder) GetAllByCreator(ctx, creator interface{}) *gomock.Call {
+func (mr *MockIEventServiceMockRecorder) GetAllByCreator(ctx, creator, organized interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCreator", reflect.TypeOf((*MockIEventService)(nil).GetAllByCreator), ctx, creator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCreator", reflect.TypeOf((*MockIEventService)(nil).GetAllByCreator), ctx, creator, organized)
 }
 
 // GetAllByStatus mocks base method.
@@ -125,10 +256,10 @@ func (mr *MockIEventServiceMockRecorder) GetAllByStatus(ctx, status interface{})
 }
 
 // GetAllByUser mocks base method.
-func (m *MockIEventService) GetAllByUser(ctx context.Context, user_id string) (*event.GetAllByUserResponse, error) {
+func (m *MockIEventService) GetAllByUser(ctx context.Context, user_id string) ([]*models.EventRegistration, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllByUser", ctx, user_id)
-	ret0, _ := ret[0].(*event.GetAllByUserResponse)
+	ret0, _ := ret[0].([]*models.EventRegistration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -140,10 +271,10 @@ func (mr *MockIEventServiceMockRecorder) GetAllByUser(ctx, user_id interface{})
 }
 
 // GetAllUsersByEvent mocks base method.
-func (m *MockIEventService) GetAllUsersByEvent(ctx context.Context, event_id int) (*event.GetAllUsersByEventResponse, error) {
+func (m *MockIEventService) GetAllUsersByEvent(ctx context.Context, event_id int) ([]*models.Registration, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllUsersByEvent", ctx, event_id)
-	ret0, _ := ret[0].(*event.GetAllUsersByEventResponse)
+	ret0, _ := ret[0].([]*models.Registration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -154,6 +285,21 @@ func (mr *MockIEventServiceMockRecorder) GetAllUsersByEvent(ctx, event_id interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsersByEvent", reflect.TypeOf((*MockIEventService)(nil).GetAllUsersByEvent), ctx, event_id)
 }
 
+// GetAttendance mocks base method.
+func (m *MockIEventService) GetAttendance(ctx context.Context, event_id int) (*models.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendance", ctx, event_id)
+	ret0, _ := ret[0].(*models.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendance indicates an expected call of GetAttendance.
+func (mr *MockIEventServiceMockRecorder) GetAttendance(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendance", reflect.TypeOf((*MockIEventService)(nil).GetAttendance), ctx, event_id)
+}
+
 // GetById mocks base method.
 func (m *MockIEventService) GetById(ctx context.Context, id int) (*models.EventResponse, error) {
 	m.ctrl.T.Helper()
@@ -169,18 +315,252 @@ func (mr *MockIEventServiceMockRecorder) GetById(ctx, id interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockIEventService)(nil).GetById), ctx, id)
 }
 
+// GetHistory mocks base method.
+func (m *MockIEventService) GetHistory(ctx context.Context, event_id int) ([]*models.EventHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, event_id)
+	ret0, _ := ret[0].([]*models.EventHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockIEventServiceMockRecorder) GetHistory(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockIEventService)(nil).GetHistory), ctx, event_id)
+}
+
+// GetInvites mocks base method.
+func (m *MockIEventService) GetInvites(ctx context.Context, event_id int) ([]*models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvites", ctx, event_id)
+	ret0, _ := ret[0].([]*models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvites indicates an expected call of GetInvites.
+func (mr *MockIEventServiceMockRecorder) GetInvites(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvites", reflect.TypeOf((*MockIEventService)(nil).GetInvites), ctx, event_id)
+}
+
+// GetOrganizers mocks base method.
+func (m *MockIEventService) GetOrganizers(ctx context.Context, event_id int) ([]*models.Organizer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizers", ctx, event_id)
+	ret0, _ := ret[0].([]*models.Organizer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizers indicates an expected call of GetOrganizers.
+func (mr *MockIEventServiceMockRecorder) GetOrganizers(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizers", reflect.TypeOf((*MockIEventService)(nil).GetOrganizers), ctx, event_id)
+}
+
+// GetPending mocks base method.
+func (m *MockIEventService) GetPending(ctx context.Context, event_id int) ([]*models.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", ctx, event_id)
+	ret0, _ := ret[0].([]*models.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *MockIEventServiceMockRecorder) GetPending(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockIEventService)(nil).GetPending), ctx, event_id)
+}
+
+// GetQuestions mocks base method.
+func (m *MockIEventService) GetQuestions(ctx context.Context, event_id int) ([]*models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestions", ctx, event_id)
+	ret0, _ := ret[0].([]*models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestions indicates an expected call of GetQuestions.
+func (mr *MockIEventServiceMockRecorder) GetQuestions(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestions", reflect.TypeOf((*MockIEventService)(nil).GetQuestions), ctx, event_id)
+}
+
+// GetRegistration mocks base method.
+func (m *MockIEventService) GetRegistration(ctx context.Context, user_id string, event_id int) (*models.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistration", ctx, user_id, event_id)
+	ret0, _ := ret[0].(*models.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistration indicates an expected call of GetRegistration.
+func (mr *MockIEventServiceMockRecorder) GetRegistration(ctx, user_id, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistration", reflect.TypeOf((*MockIEventService)(nil).GetRegistration), ctx, user_id, event_id)
+}
+
+// GetRevision mocks base method.
+func (m *MockIEventService) GetRevision(ctx context.Context, event_id, revision int) (*models.EventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, event_id, revision)
+	ret0, _ := ret[0].(*models.EventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockIEventServiceMockRecorder) GetRevision(ctx, event_id, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockIEventService)(nil).GetRevision), ctx, event_id, revision)
+}
+
+// GetTicket mocks base method.
+func (m *MockIEventService) GetTicket(ctx context.Context, user_id string, event_id int, qr bool) (*models.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicket", ctx, user_id, event_id, qr)
+	ret0, _ := ret[0].(*models.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicket indicates an expected call of GetTicket.
+func (mr *MockIEventServiceMockRecorder) GetTicket(ctx, user_id, event_id, qr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*MockIEventService)(nil).GetTicket), ctx, user_id, event_id, qr)
+}
+
+// GetTicketTypes mocks base method.
+func (m *MockIEventService) GetTicketTypes(ctx context.Context, event_id int) ([]*models.TicketType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketTypes", ctx, event_id)
+	ret0, _ := ret[0].([]*models.TicketType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketTypes indicates an expected call of GetTicketTypes.
+func (mr *MockIEventServiceMockRecorder) GetTicketTypes(ctx, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketTypes", reflect.TypeOf((*MockIEventService)(nil).GetTicketTypes), ctx, event_id)
+}
+
 // Register mocks base method.
-func (m *MockIEventService) Register(ctx context.Context, user_id string, event_id int) error {
+func (m *MockIEventService) Register(ctx context.Context, user_id string, event_id, ticket_type_id, guests int, answers []models.Answer, code string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", ctx, user_id, event_id)
+	ret := m.ctrl.Call(m, "Register", ctx, user_id, event_id, ticket_type_id, guests, answers, code)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockIEventServiceMockRecorder) Register(ctx, user_id, event_id interface{}) *gomock.Call {
+func (mr *MockIEventServiceMockRecorder) Register(ctx, user_id, event_id, ticket_type_id, guests, answers, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIEventService)(nil).Register), ctx, user_id, event_id, ticket_type_id, guests, answers, code)
+}
+
+// RegisterGroup mocks base method.
+func (m *MockIEventService) RegisterGroup(ctx context.Context, event_id int, user_ids []string, ticket_type_id int, partial bool) ([]*models.GroupOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterGroup", ctx, event_id, user_ids, ticket_type_id, partial)
+	ret0, _ := ret[0].([]*models.GroupOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterGroup indicates an expected call of RegisterGroup.
+func (mr *MockIEventServiceMockRecorder) RegisterGroup(ctx, event_id, user_ids, ticket_type_id, partial interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterGroup", reflect.TypeOf((*MockIEventService)(nil).RegisterGroup), ctx, event_id, user_ids, ticket_type_id, partial)
+}
+
+// Reject mocks base method.
+func (m *MockIEventService) Reject(ctx context.Context, user_id string, event_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, user_id, event_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockIEventServiceMockRecorder) Reject(ctx, user_id, event_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockIEventService)(nil).Reject), ctx, user_id, event_id)
+}
+
+// RemoveOrganizer mocks base method.
+func (m *MockIEventService) RemoveOrganizer(ctx context.Context, event_id int, user_id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrganizer", ctx, event_id, user_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrganizer indicates an expected call of RemoveOrganizer.
+func (mr *MockIEventServiceMockRecorder) RemoveOrganizer(ctx, event_id, user_id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizer", reflect.TypeOf((*MockIEventService)(nil).RemoveOrganizer), ctx, event_id, user_id)
+}
+
+// Restore mocks base method.
+func (m *MockIEventService) Restore(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockIEventServiceMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockIEventService)(nil).Restore), ctx, id)
+}
+
+// RevokeInvite mocks base method.
+func (m *MockIEventService) RevokeInvite(ctx context.Context, event_id int, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvite", ctx, event_id, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockIEventServiceMockRecorder) RevokeInvite(ctx, event_id, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockIEventService)(nil).RevokeInvite), ctx, event_id, code)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockIEventService) TransferOwnership(ctx context.Context, event_id int, to string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", ctx, event_id, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockIEventServiceMockRecorder) TransferOwnership(ctx, event_id, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockIEventService)(nil).TransferOwnership), ctx, event_id, to)
+}
+
+// TransferRegistration mocks base method.
+func (m *MockIEventService) TransferRegistration(ctx context.Context, event_id int, from, to string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferRegistration", ctx, event_id, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferRegistration indicates an expected call of TransferRegistration.
+func (mr *MockIEventServiceMockRecorder) TransferRegistration(ctx, event_id, from, to interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIEventService)(nil).Register), ctx, user_id, event_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferRegistration", reflect.TypeOf((*MockIEventService)(nil).TransferRegistration), ctx, event_id, from, to)
 }
 
 // Update mocks base method.
@@ -6,6 +6,7 @@ import (
 	"github.com/Estriper0/EventService/internal/models"
 )
 
+// IEventService is the business logic of events.
 type IEventService interface {
 	GetById(
 		ctx context.Context,
@@ -21,6 +22,7 @@ type IEventService interface {
 	GetAllByCreator(
 		ctx context.Context,
 		creator string,
+		organized bool,
 	) ([]*models.EventResponse, error)
 	GetAllByStatus(
 		ctx context.Context,
@@ -29,6 +31,11 @@ type IEventService interface {
 	DeleteById(
 		ctx context.Context,
 		id int,
+		version int,
+	) error
+	Restore(
+		ctx context.Context,
+		id int,
 	) error
 	Update(
 		ctx context.Context,
@@ -38,6 +45,29 @@ type IEventService interface {
 		ctx context.Context,
 		user_id string,
 		event_id int,
+		ticket_type_id int,
+		guests int,
+		answers []models.Answer,
+		code string,
+	) error
+	RegisterGroup(
+		ctx context.Context,
+		event_id int,
+		user_ids []string,
+		ticket_type_id int,
+		partial bool,
+	) ([]*models.GroupOutcome, error)
+	TransferRegistration(
+		ctx context.Context,
+		event_id int,
+		from string,
+		to string,
+	) error
+	ChangeGuests(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+		guests int,
 	) error
 	CancellRegister(
 		ctx context.Context,
@@ -47,9 +77,110 @@ type IEventService interface {
 	GetAllByUser(
 		ctx context.Context,
 		user_id string,
-	) ([]*models.EventResponse, error)
+	) ([]*models.EventRegistration, error)
 	GetAllUsersByEvent(
 		ctx context.Context,
 		event_id int,
-	) (*[]string, error)
+	) ([]*models.Registration, error)
+	GetPending(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.Registration, error)
+	Approve(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+	) error
+	Reject(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+	) error
+	GetRegistration(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+	) (*models.Registration, error)
+	GetTicket(
+		ctx context.Context,
+		user_id string,
+		event_id int,
+		qr bool,
+	) (*models.Ticket, error)
+	CheckIn(
+		ctx context.Context,
+		token string,
+	) (*models.Registration, error)
+	GetAttendance(
+		ctx context.Context,
+		event_id int,
+	) (*models.Attendance, error)
+	CreateTicketType(
+		ctx context.Context,
+		ticketType *models.TicketType,
+	) (int, error)
+	GetTicketTypes(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.TicketType, error)
+	GetHistory(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.EventHistory, error)
+	GetRevision(
+		ctx context.Context,
+		event_id int,
+		revision int,
+	) (*models.EventResponse, error)
+	CreateQuestion(
+		ctx context.Context,
+		question *models.Question,
+	) (int, error)
+	GetQuestions(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.Question, error)
+	DeleteQuestion(
+		ctx context.Context,
+		event_id int,
+		id int,
+	) error
+	ExportAnswers(
+		ctx context.Context,
+		event_id int,
+	) (*models.AnswerExport, error)
+	CreateInvite(
+		ctx context.Context,
+		event_id int,
+		user_id string,
+	) (*models.Invite, error)
+	GetInvites(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.Invite, error)
+	RevokeInvite(
+		ctx context.Context,
+		event_id int,
+		code string,
+	) error
+	GetOrganizers(
+		ctx context.Context,
+		event_id int,
+	) ([]*models.Organizer, error)
+	AddOrganizer(
+		ctx context.Context,
+		event_id int,
+		user_id string,
+		role string,
+	) (*models.Organizer, error)
+	RemoveOrganizer(
+		ctx context.Context,
+		event_id int,
+		user_id string,
+	) error
+	TransferOwnership(
+		ctx context.Context,
+		event_id int,
+		to string,
+	) error
 }
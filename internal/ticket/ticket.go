@@ -0,0 +1,64 @@
+package ticket
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+var ErrInvalid = errors.New("invalid ticket")
+
+// Signer issues and verifies ticket tokens of the form
+// "<event_id>.<user_id>.<signature>", where the signature is an
+// HMAC-SHA256 of the event and user id.
+type Signer struct {
+	key []byte
+}
+
+// New returns a Signer using the secret as the HMAC key, or nil when the
+// secret is empty.
+func New(secret string) *Signer {
+	if secret == "" {
+		return nil
+	}
+	return &Signer{key: []byte(secret)}
+}
+
+// Issue returns the ticket token of the user for the event.
+func (s *Signer) Issue(event_id int, user_id string) string {
+	payload := strconv.Itoa(event_id) + "." + user_id
+	return payload + "." + s.sign(payload)
+}
+
+// Verify checks the signature of the token and returns the event and user id it was issued for.
+func (s *Signer) Verify(token string) (int, string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[1] == "" {
+		return 0, "", ErrInvalid
+	}
+	event_id, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", ErrInvalid
+	}
+	signature := []byte(s.sign(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, []byte(parts[2])) {
+		return 0, "", ErrInvalid
+	}
+	return event_id, parts[1], nil
+}
+
+func (s *Signer) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// QR renders the token as a QR code PNG of the given size in pixels.
+func QR(token string, size int) ([]byte, error) {
+	return qrcode.Encode(token, qrcode.Medium, size)
+}
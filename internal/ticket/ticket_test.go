@@ -0,0 +1,80 @@
+package ticket
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	signer := New("secret")
+	userID := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	token := signer.Issue(42, userID)
+
+	tests := []struct {
+		name      string
+		signer    *Signer
+		token     string
+		wantEvent int
+		wantUser  string
+		wantErr   error
+	}{
+		{
+			name:      "valid",
+			signer:    signer,
+			token:     token,
+			wantEvent: 42,
+			wantUser:  userID,
+		},
+		{
+			name:    "other event",
+			signer:  signer,
+			token:   "43" + token[2:],
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "other secret",
+			signer:  New("another secret"),
+			token:   token,
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "malformed",
+			signer:  signer,
+			token:   "42." + userID,
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "not a number",
+			signer:  signer,
+			token:   "event." + userID + ".signature",
+			wantErr: ErrInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, user, err := tt.signer.Verify(tt.token)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantEvent, event)
+			assert.Equal(t, tt.wantUser, user)
+		})
+	}
+}
+
+func TestNew_EmptySecret(t *testing.T) {
+	assert.Nil(t, New(""))
+}
+
+func TestQR(t *testing.T) {
+	data, err := QR(New("secret").Issue(1, "ea27ecf4-02b1-453d-965d-408253a874b9"), 256)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 256, img.Bounds().Dx())
+}
@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/Estriper0/EventService/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// New configures the global tracer provider with an OTLP exporter.
+// When tracing is disabled the global no-op provider is kept.
+func New(ctx context.Context, cfg *config.Tracing) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewProvider(
+		cfg,
+		sdktrace.WithBatcher(exporter),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
+
+// NewProvider builds a tracer provider for the service. Tests pass
+// sdktrace.WithSyncer(tracetest.NewInMemoryExporter()) to inspect spans.
+func NewProvider(cfg *config.Tracing, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	opts = append(
+		opts,
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", cfg.ServiceName),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	return sdktrace.NewTracerProvider(opts...)
+}
+
+// RecordError marks the span as failed.
+func RecordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// DB returns the attributes of a span for a Postgres query.
+func DB(query string) trace.SpanStartOption {
+	return trace.WithAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.statement", query),
+	)
+}
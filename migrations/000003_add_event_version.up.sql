@@ -0,0 +1 @@
+ALTER TABLE event.events ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS event.event_history;
+DROP TYPE IF EXISTS event_action;
\ No newline at end of file
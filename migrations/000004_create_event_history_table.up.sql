@@ -0,0 +1,19 @@
+CREATE TYPE event_action AS ENUM (
+    'create',
+    'update',
+    'status_change',
+    'delete'
+);
+
+CREATE TABLE IF NOT EXISTS event.event_history (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    revision INTEGER NOT NULL,
+    action event_action NOT NULL,
+    actor UUID NOT NULL,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    changes JSONB NOT NULL DEFAULT '{}',
+    snapshot JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_history_event_id ON event.event_history(event_id, revision);
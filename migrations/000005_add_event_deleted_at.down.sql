@@ -0,0 +1,5 @@
+DELETE FROM event.events WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS event.idx_events_deleted_at;
+
+ALTER TABLE event.events DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,5 @@
+ALTER TABLE event.events ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_events_deleted_at ON event.events(deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TYPE event_action ADD VALUE IF NOT EXISTS 'restore';
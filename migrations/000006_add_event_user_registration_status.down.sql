@@ -0,0 +1,9 @@
+DELETE FROM event.event_user WHERE status = 'cancelled';
+
+ALTER TABLE event.event_user
+    DROP COLUMN IF EXISTS cancellations,
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS registered_at,
+    DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS registration_status;
@@ -0,0 +1,10 @@
+CREATE TYPE registration_status AS ENUM (
+    'active',
+    'cancelled'
+);
+
+ALTER TABLE event.event_user
+    ADD COLUMN IF NOT EXISTS status registration_status NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS registered_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS cancellations INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+DELETE FROM event.event_user WHERE status IN ('pending', 'rejected');
+
+ALTER TABLE event.event_user ALTER COLUMN status DROP DEFAULT;
+ALTER TYPE registration_status RENAME TO registration_status_old;
+CREATE TYPE registration_status AS ENUM (
+    'active',
+    'cancelled'
+);
+ALTER TABLE event.event_user
+    ALTER COLUMN status TYPE registration_status USING status::text::registration_status,
+    ALTER COLUMN status SET DEFAULT 'active';
+DROP TYPE registration_status_old;
+
+ALTER TABLE event.events DROP COLUMN IF EXISTS requires_approval;
@@ -0,0 +1,4 @@
+ALTER TABLE event.events ADD COLUMN IF NOT EXISTS requires_approval BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TYPE registration_status ADD VALUE IF NOT EXISTS 'pending';
+ALTER TYPE registration_status ADD VALUE IF NOT EXISTS 'rejected';
@@ -0,0 +1 @@
+ALTER TABLE event.event_user DROP COLUMN IF EXISTS checked_in_at;
@@ -0,0 +1 @@
+ALTER TABLE event.event_user ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP;
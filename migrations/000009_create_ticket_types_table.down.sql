@@ -0,0 +1,3 @@
+ALTER TABLE event.event_user DROP COLUMN IF EXISTS ticket_type_id;
+
+DROP TABLE IF EXISTS event.ticket_types;
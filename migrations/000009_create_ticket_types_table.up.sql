@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS event.ticket_types (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL REFERENCES event.events(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    sold INTEGER NOT NULL DEFAULT 0 CHECK (sold <= capacity AND sold >= 0),
+    sale_starts_at TIMESTAMP,
+    sale_ends_at TIMESTAMP,
+    hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE(event_id, name)
+);
+
+ALTER TABLE event.event_user ADD COLUMN IF NOT EXISTS ticket_type_id INTEGER REFERENCES event.ticket_types(id);
@@ -0,0 +1,4 @@
+ALTER TABLE event.events
+    DROP COLUMN IF EXISTS cancellation_cutoff,
+    DROP COLUMN IF EXISTS registration_closes_at,
+    DROP COLUMN IF EXISTS registration_opens_at;
@@ -0,0 +1,4 @@
+ALTER TABLE event.events
+    ADD COLUMN IF NOT EXISTS registration_opens_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS registration_closes_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS cancellation_cutoff INTEGER CHECK (cancellation_cutoff >= 0);
@@ -0,0 +1,3 @@
+ALTER TABLE event.event_user DROP COLUMN IF EXISTS guests;
+
+ALTER TABLE event.events DROP COLUMN IF EXISTS max_guests;
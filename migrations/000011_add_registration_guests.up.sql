@@ -0,0 +1,3 @@
+ALTER TABLE event.events ADD COLUMN IF NOT EXISTS max_guests INTEGER NOT NULL DEFAULT 0 CHECK (max_guests >= 0);
+
+ALTER TABLE event.event_user ADD COLUMN IF NOT EXISTS guests INTEGER NOT NULL DEFAULT 0 CHECK (guests >= 0);
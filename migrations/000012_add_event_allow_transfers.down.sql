@@ -0,0 +1 @@
+ALTER TABLE event.events DROP COLUMN IF EXISTS allow_transfers;
@@ -0,0 +1 @@
+ALTER TABLE event.events ADD COLUMN IF NOT EXISTS allow_transfers BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+ALTER TABLE event.event_user DROP COLUMN IF EXISTS answers;
+
+DROP TABLE IF EXISTS event.registration_questions;
+
+DROP TYPE IF EXISTS question_type;
@@ -0,0 +1,20 @@
+CREATE TYPE question_type AS ENUM (
+    'text',
+    'single_choice',
+    'multi_choice',
+    'boolean'
+);
+
+CREATE TABLE IF NOT EXISTS event.registration_questions (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL REFERENCES event.events(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    label VARCHAR(255) NOT NULL,
+    type question_type NOT NULL,
+    options TEXT[] NOT NULL DEFAULT '{}',
+    required BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_registration_questions_event_id ON event.registration_questions(event_id);
+
+ALTER TABLE event.event_user ADD COLUMN IF NOT EXISTS answers JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS event.event_invites;
+
+ALTER TABLE event.events DROP COLUMN IF EXISTS visibility;
+
+DROP TYPE IF EXISTS event_visibility;
@@ -0,0 +1,18 @@
+CREATE TYPE event_visibility AS ENUM (
+    'public',
+    'unlisted',
+    'private'
+);
+
+ALTER TABLE event.events ADD COLUMN IF NOT EXISTS visibility event_visibility NOT NULL DEFAULT 'public';
+
+CREATE TABLE IF NOT EXISTS event.event_invites (
+    code VARCHAR(64) PRIMARY KEY,
+    event_id INTEGER NOT NULL REFERENCES event.events(id) ON DELETE CASCADE,
+    user_id UUID,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_invites_event_id ON event.event_invites(event_id);
@@ -0,0 +1,5 @@
+DELETE FROM event.event_history WHERE action = 'ownership_transfer';
+
+DROP TABLE IF EXISTS event.event_organizers;
+
+DROP TYPE IF EXISTS organizer_role;
@@ -0,0 +1,23 @@
+CREATE TYPE organizer_role AS ENUM (
+    'owner',
+    'editor',
+    'checkin',
+    'viewer'
+);
+
+CREATE TABLE IF NOT EXISTS event.event_organizers (
+    event_id INTEGER NOT NULL REFERENCES event.events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role organizer_role NOT NULL,
+    added_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_organizers_user_id ON event.event_organizers(user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_organizers_owner ON event.event_organizers(event_id) WHERE role = 'owner';
+
+INSERT INTO event.event_organizers (event_id, user_id, role)
+SELECT id, creator, 'owner' FROM event.events
+ON CONFLICT DO NOTHING;
+
+ALTER TYPE event_action ADD VALUE IF NOT EXISTS 'ownership_transfer';
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"time"
+
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/repositories/event"
+	eventhistory "github.com/Estriper0/EventService/internal/repositories/event_history"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *TestSuite) TestEventHistoryRepository() {
+	repo := eventhistory.New(s.db)
+	actor := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	start := time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC)
+
+	snapshot := &models.EventResponse{
+		Id:           1,
+		Title:        "Team Sync",
+		About:        "Weekly team meeting",
+		StartDate:    start,
+		Location:     "Zoom",
+		Status:       models.StatusDraft,
+		MaxAttendees: 20,
+		Creator:      actor,
+		Version:      1,
+	}
+	err := repo.Create(s.ctx, &models.EventHistory{
+		EventId:  1,
+		Revision: 1,
+		Action:   models.ActionCreate,
+		Actor:    actor,
+		Snapshot: snapshot,
+	})
+	require.NoError(s.T(), err)
+
+	updated := *snapshot
+	updated.Status = models.StatusPublished
+	updated.Version = 3
+	err = repo.Create(s.ctx, &models.EventHistory{
+		EventId:  1,
+		Revision: 3,
+		Action:   models.ActionStatusChange,
+		Actor:    actor,
+		Changes: map[string]models.FieldChange{
+			models.FieldStatus: {Old: models.StatusDraft, New: models.StatusPublished},
+		},
+		Snapshot: &updated,
+	})
+	require.NoError(s.T(), err)
+
+	s.Run("get all by event", func() {
+		history, err := repo.GetAllByEvent(s.ctx, 1)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), history, 2)
+		require.Equal(s.T(), models.ActionCreate, history[0].Action)
+		require.Equal(s.T(), models.ActionStatusChange, history[1].Action)
+		require.Equal(s.T(), actor, history[1].Actor)
+		require.Equal(s.T(), models.StatusDraft, history[1].Changes[models.FieldStatus].Old)
+		require.Equal(s.T(), models.StatusPublished, history[1].Changes[models.FieldStatus].New)
+	})
+
+	s.Run("get revision", func() {
+		tests := []struct {
+			name       string
+			revision   int
+			wantErr    error
+			wantStatus string
+		}{
+			{name: "exact", revision: 1, wantStatus: models.StatusDraft},
+			{name: "gap resolves to the previous revision", revision: 2, wantStatus: models.StatusDraft},
+			{name: "latest", revision: 5, wantStatus: models.StatusPublished},
+			{name: "before the first revision", revision: 0, wantErr: repositories.ErrRecordNotFound},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				history, err := repo.GetRevision(s.ctx, 1, tt.revision)
+				require.ErrorIs(s.T(), err, tt.wantErr)
+				if tt.wantErr == nil {
+					require.Equal(s.T(), tt.wantStatus, history.Snapshot.Status)
+					require.True(s.T(), start.Equal(history.Snapshot.StartDate))
+					require.Equal(s.T(), "Team Sync", history.Snapshot.Title)
+				}
+			})
+		}
+	})
+}
+
+func (s *TestSuite) TestEventRepository_WritesHistory() {
+	repo := event.New(s.db)
+	historyRepo := eventhistory.New(s.db)
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+
+	req := &models.EventCreateRequest{Title: "Team Sync", Creator: creator, Status: models.StatusDraft, MaxAttendees: 10}
+	id, err := repo.Create(s.ctx, req, &models.EventHistory{
+		Action:   models.ActionCreate,
+		Actor:    creator,
+		Snapshot: &models.EventResponse{Title: req.Title, Creator: creator, Status: req.Status},
+	})
+	require.NoError(s.T(), err)
+
+	update := &models.EventUpdateRequest{Id: id, Status: models.StatusPublished, Fields: []string{models.FieldStatus}}
+	version, err := repo.Update(s.ctx, update, &models.EventHistory{
+		Action:   models.ActionStatusChange,
+		Actor:    creator,
+		Snapshot: &models.EventResponse{Title: req.Title, Creator: creator, Status: models.StatusPublished},
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, version)
+
+	err = repo.DeleteById(s.ctx, id, 0, &models.EventHistory{
+		Action:   models.ActionDelete,
+		Actor:    creator,
+		Snapshot: &models.EventResponse{Title: req.Title, Creator: creator, Status: models.StatusPublished},
+	})
+	require.NoError(s.T(), err)
+
+	_, err = repo.Restore(s.ctx, id, &models.EventHistory{
+		Action:   models.ActionRestore,
+		Actor:    creator,
+		Snapshot: &models.EventResponse{Title: req.Title, Creator: creator, Status: models.StatusPublished},
+	})
+	require.NoError(s.T(), err)
+
+	history, err := historyRepo.GetAllByEvent(s.ctx, id)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), history, 4)
+	for i, entry := range history {
+		require.Equal(s.T(), i+1, entry.Revision)
+		require.Equal(s.T(), id, entry.Snapshot.Id)
+		require.Equal(s.T(), i+1, entry.Snapshot.Version)
+	}
+	require.NotNil(s.T(), history[2].Snapshot.DeletedAt)
+	require.Nil(s.T(), history[3].Snapshot.DeletedAt)
+
+	s.Run("failed history rolls back the change", func() {
+		_, err := repo.Update(s.ctx, &models.EventUpdateRequest{Id: id, Title: "Renamed", Fields: []string{models.FieldTitle}}, &models.EventHistory{
+			Action:   "unknown",
+			Actor:    creator,
+			Snapshot: &models.EventResponse{Title: "Renamed"},
+		})
+		require.Error(s.T(), err)
+
+		current, err := repo.GetById(s.ctx, id)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), req.Title, current.Title)
+		require.Equal(s.T(), 4, current.Version)
+	})
+
+	s.Run("purge removes the history", func() {
+		require.NoError(s.T(), repo.DeleteById(s.ctx, id, 0, nil))
+
+		count, err := repo.Purge(s.ctx, time.Now().Add(time.Hour))
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), int64(1), count)
+
+		history, err := historyRepo.GetAllByEvent(s.ctx, id)
+		require.NoError(s.T(), err)
+		require.Empty(s.T(), history)
+	})
+}
@@ -32,7 +32,7 @@ func (s *TestSuite) TestEventRepository_GetById() {
 					Status:       models.StatusDraft,
 					MaxAttendees: 20,
 					Creator:      "ea27ecf4-02b1-453d-965d-408253a874b9",
-				})
+				}, nil)
 				require.NoError(s.T(), err)
 				return id
 			},
@@ -94,9 +94,9 @@ func (s *TestSuite) TestEventRepository_GetAll() {
 		{
 			name: "returns all events sorted by title",
 			setup: func() {
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "C Event", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft})
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "A Event", Creator: "ea28ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft})
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "B Event", Creator: "ea29ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft})
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "C Event", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft}, nil)
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "A Event", Creator: "ea28ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft}, nil)
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "B Event", Creator: "ea29ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft}, nil)
 			},
 			want: 3,
 		},
@@ -113,7 +113,7 @@ func (s *TestSuite) TestEventRepository_GetAll() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			tt.setup()
-			events, err := repo.GetAll(s.ctx)
+			events, err := repo.GetAll(s.ctx, nil)
 			require.NoError(s.T(), err)
 			require.Len(s.T(), events, tt.want)
 		})
@@ -145,7 +145,7 @@ func (s *TestSuite) TestEventRepository_Create() {
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			id, err := repo.Create(s.ctx, tt.input)
+			id, err := repo.Create(s.ctx, tt.input, nil)
 			if tt.wantErr {
 				require.Error(s.T(), err)
 				require.Zero(s.T(), id)
@@ -170,6 +170,7 @@ func (s *TestSuite) TestEventRepository_DeleteById() {
 		name    string
 		setup   func() int
 		id      int
+		version int
 		wantErr error
 	}{
 		{
@@ -177,11 +178,34 @@ func (s *TestSuite) TestEventRepository_DeleteById() {
 			setup: func() int {
 				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
 					Title: "To Be Deleted", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft,
-				})
+				}, nil)
 				return id
 			},
 			wantErr: nil,
 		},
+		{
+			name: "success - matching version",
+			setup: func() int {
+				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
+					Title: "To Be Deleted", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft,
+				}, nil)
+				return id
+			},
+			version: 1,
+			wantErr: nil,
+		},
+		{
+			name: "stale version",
+			setup: func() int {
+				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
+					Title: "To Be Deleted", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft, MaxAttendees: 10,
+				}, nil)
+				repo.IncreaseCurrentAttedance(s.ctx, id, 1)
+				return id
+			},
+			version: 1,
+			wantErr: repositories.ErrVersionMismatch,
+		},
 		{
 			name:    "not found",
 			setup:   func() int { return 0 },
@@ -196,19 +220,84 @@ func (s *TestSuite) TestEventRepository_DeleteById() {
 				tt.id = tt.setup()
 			}
 
-			err := repo.DeleteById(s.ctx, tt.id)
+			err := repo.DeleteById(s.ctx, tt.id, tt.version, nil)
 			require.ErrorIs(s.T(), err, tt.wantErr)
 
 			if tt.wantErr == nil {
 				_, getErr := repo.GetById(s.ctx, tt.id)
 				require.ErrorIs(s.T(), getErr, repositories.ErrRecordNotFound)
+
+				deleted, getErr := repo.GetDeletedById(s.ctx, tt.id)
+				require.NoError(s.T(), getErr)
+				require.NotNil(s.T(), deleted.DeletedAt)
 			}
 		})
 	}
 }
 
+func (s *TestSuite) TestEventRepository_SoftDelete() {
+	repo := event.New(s.db)
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+
+	id, err := repo.Create(s.ctx, &models.EventCreateRequest{
+		Title: "Soft Deleted", Creator: creator, Status: models.StatusDraft, MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), repo.DeleteById(s.ctx, id, 0, nil))
+
+	s.Run("excluded from lists", func() {
+		all, err := repo.GetAll(s.ctx, nil)
+		require.NoError(s.T(), err)
+		require.Empty(s.T(), all)
+
+		byCreator, err := repo.GetAllByCreator(s.ctx, creator, false, nil)
+		require.NoError(s.T(), err)
+		require.Empty(s.T(), byCreator)
+
+		byStatus, err := repo.GetAllByStatus(s.ctx, models.StatusDraft, nil)
+		require.NoError(s.T(), err)
+		require.Empty(s.T(), byStatus)
+	})
+
+	s.Run("cannot be deleted twice or registered for", func() {
+		require.ErrorIs(s.T(), repo.DeleteById(s.ctx, id, 0, nil), repositories.ErrRecordNotFound)
+		require.ErrorIs(s.T(), repo.IncreaseCurrentAttedance(s.ctx, id, 1), repositories.ErrRecordNotFound)
+	})
+
+	s.Run("restore", func() {
+		version, err := repo.Restore(s.ctx, id, nil)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), 3, version)
+
+		restored, err := repo.GetById(s.ctx, id)
+		require.NoError(s.T(), err)
+		require.Nil(s.T(), restored.DeletedAt)
+
+		_, err = repo.Restore(s.ctx, id, nil)
+		require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+	})
+
+	s.Run("purge", func() {
+		require.NoError(s.T(), repo.DeleteById(s.ctx, id, 0, nil))
+
+		count, err := repo.Purge(s.ctx, time.Now().Add(-time.Hour))
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), int64(0), count)
+
+		count, err = repo.Purge(s.ctx, time.Now().Add(time.Hour))
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), int64(1), count)
+
+		_, err = repo.GetDeletedById(s.ctx, id)
+		require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+	})
+}
+
 func (s *TestSuite) TestEventRepository_Update() {
 	repo := event.New(s.db)
+	opensAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	closesAt := time.Date(2026, 1, 19, 10, 0, 0, 0, time.UTC)
+	cutoff := 24 * time.Hour
 
 	tests := []struct {
 		name    string
@@ -222,7 +311,7 @@ func (s *TestSuite) TestEventRepository_Update() {
 			setup: func() int {
 				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
 					Title: "Old Title", About: "Old", StartDate: time.Now(), Location: "Old", Status: models.StatusDraft, MaxAttendees: 10, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9",
-				})
+				}, nil)
 				return id
 			},
 			update: &models.EventUpdateRequest{
@@ -240,6 +329,89 @@ func (s *TestSuite) TestEventRepository_Update() {
 				require.Equal(t, "New Location", e.Location)
 				require.Equal(t, models.StatusOngoing, e.Status)
 				require.Equal(t, 150, e.MaxAttendees)
+				require.Equal(t, 2, e.Version)
+			},
+		},
+		{
+			name: "registration window",
+			setup: func() int {
+				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
+					Title: "Old Title", About: "Old", StartDate: time.Now(), Location: "Old", Status: models.StatusDraft, MaxAttendees: 10, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9",
+				}, nil)
+				return id
+			},
+			update: &models.EventUpdateRequest{
+				RegistrationOpensAt:  &opensAt,
+				RegistrationClosesAt: &closesAt,
+				CancellationCutoff:   &cutoff,
+				Fields:               []string{models.FieldRegistrationOpens, models.FieldRegistrationCloses, models.FieldCancellationCutoff},
+			},
+			wantErr: nil,
+			verify: func(t *testing.T, e *models.EventResponse) {
+				require.NotNil(t, e.RegistrationOpensAt)
+				require.True(t, opensAt.Equal(*e.RegistrationOpensAt))
+				require.NotNil(t, e.RegistrationClosesAt)
+				require.True(t, closesAt.Equal(*e.RegistrationClosesAt))
+				require.NotNil(t, e.CancellationCutoff)
+				require.Equal(t, cutoff, *e.CancellationCutoff)
+				require.Equal(t, "Old Title", e.Title)
+			},
+		},
+		{
+			name: "matching version",
+			setup: func() int {
+				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
+					Title: "Old Title", About: "Old", StartDate: time.Now(), Location: "Old", Status: models.StatusDraft, MaxAttendees: 10, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9",
+				}, nil)
+				return id
+			},
+			update: &models.EventUpdateRequest{
+				Title:   "New Title",
+				Version: 1,
+				Fields:  []string{models.FieldTitle},
+			},
+			wantErr: nil,
+			verify: func(t *testing.T, e *models.EventResponse) {
+				require.Equal(t, "New Title", e.Title)
+				require.Equal(t, 2, e.Version)
+			},
+		},
+		{
+			name: "stale version",
+			setup: func() int {
+				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
+					Title: "Old Title", About: "Old", StartDate: time.Now(), Location: "Old", Status: models.StatusDraft, MaxAttendees: 10, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9",
+				}, nil)
+				repo.Update(s.ctx, &models.EventUpdateRequest{Id: id, Title: "Other Title", Fields: []string{models.FieldTitle}}, nil)
+				return id
+			},
+			update: &models.EventUpdateRequest{
+				Title:   "New Title",
+				Version: 1,
+				Fields:  []string{models.FieldTitle},
+			},
+			wantErr: repositories.ErrVersionMismatch,
+		},
+		{
+			name: "partial update",
+			setup: func() int {
+				id, _ := repo.Create(s.ctx, &models.EventCreateRequest{
+					Title: "Old Title", About: "Old", StartDate: time.Now(), Location: "Old", Status: models.StatusDraft, MaxAttendees: 10, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9",
+				}, nil)
+				return id
+			},
+			update: &models.EventUpdateRequest{
+				Title:  "New Title",
+				Status: models.StatusPublished,
+				Fields: []string{models.FieldTitle, models.FieldStatus},
+			},
+			wantErr: nil,
+			verify: func(t *testing.T, e *models.EventResponse) {
+				require.Equal(t, "New Title", e.Title)
+				require.Equal(t, models.StatusPublished, e.Status)
+				require.Equal(t, "Old", e.About)
+				require.Equal(t, "Old", e.Location)
+				require.Equal(t, 10, e.MaxAttendees)
 			},
 		},
 		{
@@ -258,12 +430,13 @@ func (s *TestSuite) TestEventRepository_Update() {
 				tt.update.Id = id
 			}
 
-			err := repo.Update(s.ctx, tt.update)
+			version, err := repo.Update(s.ctx, tt.update, nil)
 			require.ErrorIs(s.T(), err, tt.wantErr)
 
 			if tt.wantErr == nil && tt.verify != nil {
 				updated, getErr := repo.GetById(s.ctx, id)
 				require.NoError(s.T(), getErr)
+				require.Equal(s.T(), updated.Version, version)
 				tt.verify(s.T(), updated)
 			}
 		})
@@ -282,9 +455,9 @@ func (s *TestSuite) TestEventRepository_GetAllByCreator() {
 		{
 			name: "multiple events by same creator",
 			setup: func() {
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "Event 1", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusPublished})
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "Event 2", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft})
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "Event 3", Creator: "ea29ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusPublished})
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "Event 1", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusPublished}, nil)
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "Event 2", Creator: "ea27ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusDraft}, nil)
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "Event 3", Creator: "ea29ecf4-02b1-453d-965d-408253a874b9", Status: models.StatusPublished}, nil)
 			},
 			creator: "ea27ecf4-02b1-453d-965d-408253a874b9",
 			wantLen: 2,
@@ -300,7 +473,7 @@ func (s *TestSuite) TestEventRepository_GetAllByCreator() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			tt.setup()
-			events, err := repo.GetAllByCreator(s.ctx, tt.creator)
+			events, err := repo.GetAllByCreator(s.ctx, tt.creator, false, nil)
 			require.NoError(s.T(), err)
 			require.Len(s.T(), events, tt.wantLen)
 
@@ -323,10 +496,10 @@ func (s *TestSuite) TestEventRepository_GetAllByStatus() {
 		{
 			name: "filter by published",
 			setup: func() {
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "A", Status: models.StatusPublished, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"})
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "B", Status: models.StatusDraft, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"})
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "C", Status: models.StatusPublished, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"})
-				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "D", Status: models.StatusCompleted, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"})
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "A", Status: models.StatusPublished, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"}, nil)
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "B", Status: models.StatusDraft, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"}, nil)
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "C", Status: models.StatusPublished, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"}, nil)
+				_, _ = repo.Create(s.ctx, &models.EventCreateRequest{Title: "D", Status: models.StatusCompleted, Creator: "ea27ecf4-02b1-453d-965d-408253a874b9"}, nil)
 			},
 			status:  models.StatusPublished,
 			wantLen: 2,
@@ -343,7 +516,7 @@ func (s *TestSuite) TestEventRepository_GetAllByStatus() {
 		s.Run(tt.name, func() {
 			tt.setup()
 
-			events, err := repo.GetAllByStatus(s.ctx, tt.status)
+			events, err := repo.GetAllByStatus(s.ctx, tt.status, nil)
 			require.NoError(s.T(), err)
 			require.Len(s.T(), events, tt.wantLen)
 
@@ -371,7 +544,7 @@ func (s *TestSuite) TestEventRepository_IncreaseCurrentAttedance() {
 					MaxAttendees: 10,
 					Creator:      "ea27ecf4-02b1-453d-965d-408253a874b9",
 					Status:       models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
 				return id
 			},
@@ -385,9 +558,9 @@ func (s *TestSuite) TestEventRepository_IncreaseCurrentAttedance() {
 					MaxAttendees: 1,
 					Creator:      "ea27ecf4-02b1-453d-965d-408253a874b9",
 					Status:       models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
-				err = repo.IncreaseCurrentAttedance(s.ctx, id)
+				err = repo.IncreaseCurrentAttedance(s.ctx, id, 1)
 				require.NoError(s.T(), err)
 				return id
 			},
@@ -406,7 +579,7 @@ func (s *TestSuite) TestEventRepository_IncreaseCurrentAttedance() {
 				tt.eventID = tt.setup()
 			}
 
-			err := repo.IncreaseCurrentAttedance(s.ctx, tt.eventID)
+			err := repo.IncreaseCurrentAttedance(s.ctx, tt.eventID, 1)
 
 			if tt.wantErr != nil {
 				require.ErrorIs(s.T(), err, tt.wantErr)
@@ -437,9 +610,9 @@ func (s *TestSuite) TestEventRepository_DecreaseCurrentAttedance() {
 					MaxAttendees: 10,
 					Creator:      "ea27ecf4-02b1-453d-965d-408253a874b9",
 					Status:       models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
-				err = repo.IncreaseCurrentAttedance(s.ctx, id)
+				err = repo.IncreaseCurrentAttedance(s.ctx, id, 1)
 				require.NoError(s.T(), err)
 				return id
 			},
@@ -458,7 +631,7 @@ func (s *TestSuite) TestEventRepository_DecreaseCurrentAttedance() {
 				tt.eventID = tt.setup()
 			}
 
-			err := repo.DecreaseCurrentAttedance(s.ctx, tt.eventID)
+			err := repo.DecreaseCurrentAttedance(s.ctx, tt.eventID, 1)
 
 			if tt.wantErr != nil {
 				require.ErrorIs(s.T(), err, tt.wantErr)
@@ -491,18 +664,18 @@ func (s *TestSuite) TestEventRepository_GetAllByUser() {
 					Title:   "Event 1",
 					Creator: userID,
 					Status:  models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
 				event2, err := repo.Create(s.ctx, &models.EventCreateRequest{
 					Title:   "Event 2",
 					Creator: userID,
 					Status:  models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
 
-				err = userRepo.Create(s.ctx, userID, event1)
+				err = userRepo.Create(s.ctx, &models.Registration{UserId: userID, EventId: event1, Status: models.RegistrationActive})
 				require.NoError(s.T(), err)
-				err = userRepo.Create(s.ctx, userID, event2)
+				err = userRepo.Create(s.ctx, &models.Registration{UserId: userID, EventId: event2, Status: models.RegistrationActive})
 				require.NoError(s.T(), err)
 
 				return userID
@@ -524,7 +697,7 @@ func (s *TestSuite) TestEventRepository_GetAllByUser() {
 				tt.userID = tt.setup()
 			}
 
-			events, err := repo.GetAllByUser(s.ctx, tt.userID)
+			events, err := repo.GetAllByUser(s.ctx, tt.userID, nil)
 
 			require.ErrorIs(s.T(), err, tt.wantErr)
 			require.Len(s.T(), events, tt.wantLen)
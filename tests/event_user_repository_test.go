@@ -6,6 +6,7 @@ import (
 	"github.com/Estriper0/EventService/internal/repositories"
 	"github.com/Estriper0/EventService/internal/repositories/event"
 	eventuser "github.com/Estriper0/EventService/internal/repositories/event_user"
+	tickettype "github.com/Estriper0/EventService/internal/repositories/ticket_type"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,9 +30,9 @@ func (s *TestSuite) TestEventUserRepository_Exists() {
 					Title:   "Event",
 					Creator: userID,
 					Status:  models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
-				err = repo.Create(s.ctx, userID, eventID)
+				err = repo.Create(s.ctx, &models.Registration{UserId: userID, EventId: eventID, Status: models.RegistrationActive})
 				require.NoError(s.T(), err)
 				return userID, eventID
 			},
@@ -80,7 +81,7 @@ func (s *TestSuite) TestEventUserRepository_Create() {
 					Title:   "Event",
 					Creator: userID,
 					Status:  models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
 				return userID, eventID
 			},
@@ -94,9 +95,9 @@ func (s *TestSuite) TestEventUserRepository_Create() {
 					Title:   "Event",
 					Creator: userID,
 					Status:  models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
-				err = repo.Create(s.ctx, userID, eventID)
+				err = repo.Create(s.ctx, &models.Registration{UserId: userID, EventId: eventID, Status: models.RegistrationActive})
 				require.NoError(s.T(), err)
 				return userID, eventID
 			},
@@ -110,14 +111,14 @@ func (s *TestSuite) TestEventUserRepository_Create() {
 				tt.userID, tt.eventID = tt.setup()
 			}
 
-			err := repo.Create(s.ctx, tt.userID, tt.eventID)
+			err := repo.Create(s.ctx, &models.Registration{UserId: tt.userID, EventId: tt.eventID, Status: models.RegistrationActive})
 
 			require.ErrorIs(s.T(), err, tt.wantErr)
 		})
 	}
 }
 
-func (s *TestSuite) TestEventUserRepository_Delete() {
+func (s *TestSuite) TestEventUserRepository_Cancel() {
 	repo := eventuser.New(s.db)
 	eventRepo := event.New(s.db)
 
@@ -136,9 +137,9 @@ func (s *TestSuite) TestEventUserRepository_Delete() {
 					Title:   "Event",
 					Creator: userID,
 					Status:  models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
-				err = repo.Create(s.ctx, userID, eventID)
+				err = repo.Register(s.ctx, &models.Registration{UserId: userID, EventId: eventID, Status: models.RegistrationActive})
 				require.NoError(s.T(), err)
 				return userID, eventID
 			},
@@ -158,9 +159,29 @@ func (s *TestSuite) TestEventUserRepository_Delete() {
 				tt.userID, tt.eventID = tt.setup()
 			}
 
-			err := repo.Delete(s.ctx, tt.userID, tt.eventID)
+			err := repo.Cancel(s.ctx, tt.userID, tt.eventID)
 
 			require.ErrorIs(s.T(), err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				exists, err := repo.Exists(s.ctx, tt.userID, tt.eventID)
+				require.NoError(s.T(), err)
+				require.False(s.T(), exists)
+
+				registrations, err := repo.GetAllByEvent(s.ctx, tt.eventID)
+				require.NoError(s.T(), err)
+				require.Len(s.T(), registrations, 1)
+				require.Equal(s.T(), models.RegistrationCancelled, registrations[0].Status)
+				require.NotNil(s.T(), registrations[0].CancelledAt)
+				require.Equal(s.T(), 1, registrations[0].Cancellations)
+
+				stored, err := eventRepo.GetById(s.ctx, tt.eventID)
+				require.NoError(s.T(), err)
+				require.Equal(s.T(), 0, stored.CurrentAttendance)
+
+				err = repo.Cancel(s.ctx, tt.userID, tt.eventID)
+				require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+			}
 		})
 	}
 }
@@ -185,11 +206,11 @@ func (s *TestSuite) TestEventUserRepository_GetAllByEvent() {
 					Title:   "Event",
 					Creator: user1,
 					Status:  models.StatusPublished,
-				})
+				}, nil)
 				require.NoError(s.T(), err)
-				err = repo.Create(s.ctx, user1, eventID)
+				err = repo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive})
 				require.NoError(s.T(), err)
-				err = repo.Create(s.ctx, user2, eventID)
+				err = repo.Create(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationActive})
 				require.NoError(s.T(), err)
 				return eventID
 			},
@@ -213,7 +234,317 @@ func (s *TestSuite) TestEventUserRepository_GetAllByEvent() {
 			got, err := repo.GetAllByEvent(s.ctx, tt.eventID)
 
 			require.ErrorIs(s.T(), err, tt.wantErr)
-			require.Len(s.T(), *got, tt.wantLen)
+			require.Len(s.T(), got, tt.wantLen)
 		})
 	}
 }
+
+func (s *TestSuite) TestEventUserRepository_RegisterAgain() {
+	repo := eventuser.New(s.db)
+	eventRepo := event.New(s.db)
+	userID := "ea27ecf4-02b1-453d-965d-408253a874b9"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Event",
+		Creator:      userID,
+		Status:       models.StatusPublished,
+		MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), repo.Register(s.ctx, &models.Registration{UserId: userID, EventId: eventID, Status: models.RegistrationActive}))
+	require.NoError(s.T(), repo.Cancel(s.ctx, userID, eventID))
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: userID, EventId: eventID, Status: models.RegistrationActive}))
+	require.ErrorIs(s.T(), repo.Create(s.ctx, &models.Registration{UserId: userID, EventId: eventID, Status: models.RegistrationActive}), repositories.ErrAlreadyExists)
+
+	registrations, err := repo.GetAllByEvent(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), registrations, 1)
+	require.Equal(s.T(), models.RegistrationActive, registrations[0].Status)
+	require.Nil(s.T(), registrations[0].CancelledAt)
+	require.Equal(s.T(), 1, registrations[0].Cancellations)
+
+	events, err := eventRepo.GetAllByUser(s.ctx, userID, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), events, 1)
+	require.Equal(s.T(), eventID, events[0].Event.Id)
+	require.Equal(s.T(), models.RegistrationActive, events[0].Registration.Status)
+	require.Equal(s.T(), 1, events[0].Registration.Cancellations)
+	require.False(s.T(), events[0].Registration.RegisteredAt.IsZero())
+}
+
+func (s *TestSuite) TestEventUserRepository_Register() {
+	repo := eventuser.New(s.db)
+	eventRepo := event.New(s.db)
+	ticketTypeRepo := tickettype.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user2 := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	user3 := "9b1c7d2e-3f4a-4b5c-8d6e-7f8091a2b3c4"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Event",
+		Creator:      user1,
+		Status:       models.StatusPublished,
+		MaxAttendees: 3,
+	}, nil)
+	require.NoError(s.T(), err)
+	vipID, err := ticketTypeRepo.Create(s.ctx, &models.TicketType{EventId: eventID, Name: "VIP", Capacity: 1})
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), repo.Register(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive, TicketTypeId: vipID}))
+	require.ErrorIs(s.T(), repo.Register(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive}), repositories.ErrAlreadyExists)
+	require.ErrorIs(s.T(), repo.Register(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationActive, TicketTypeId: vipID}), repositories.ErrSoldOut)
+	require.ErrorIs(s.T(), repo.Register(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationActive, Guests: 2}), repositories.ErrMaxRegistered)
+	require.NoError(s.T(), repo.Register(s.ctx, &models.Registration{UserId: user3, EventId: eventID, Status: models.RegistrationPending}))
+
+	_, err = repo.Get(s.ctx, user2, eventID)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound, "the registration is rolled back with its seats")
+
+	vip, err := ticketTypeRepo.GetById(s.ctx, vipID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, vip.Sold)
+	stored, err := eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, stored.CurrentAttendance)
+
+	require.NoError(s.T(), eventRepo.DeleteById(s.ctx, eventID, 0, nil))
+	require.ErrorIs(s.T(), repo.Register(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationActive}), repositories.ErrRecordNotFound)
+}
+
+func (s *TestSuite) TestEventUserRepository_Approval() {
+	repo := eventuser.New(s.db)
+	eventRepo := event.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user2 := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:            "Event",
+		Creator:          user1,
+		Status:           models.StatusPublished,
+		MaxAttendees:     1,
+		RequiresApproval: true,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	stored, err := eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.True(s.T(), stored.RequiresApproval)
+
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationPending}))
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationPending}))
+
+	exists, err := repo.Exists(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.False(s.T(), exists)
+
+	require.NoError(s.T(), repo.Approve(s.ctx, user1, eventID))
+	require.ErrorIs(s.T(), repo.Approve(s.ctx, user1, eventID), repositories.ErrRecordNotFound)
+	require.ErrorIs(s.T(), repo.Approve(s.ctx, user2, eventID), repositories.ErrMaxRegistered)
+
+	registration, err := repo.Get(s.ctx, user2, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.RegistrationPending, registration.Status)
+
+	require.NoError(s.T(), repo.Reject(s.ctx, user2, eventID))
+	require.ErrorIs(s.T(), repo.Reject(s.ctx, user2, eventID), repositories.ErrRecordNotFound)
+	require.ErrorIs(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationPending}), repositories.ErrAlreadyExists)
+
+	registration, err = repo.Get(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.RegistrationActive, registration.Status)
+
+	stored, err = eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, stored.CurrentAttendance)
+
+	_, err = repo.Get(s.ctx, "9b1c7d2e-3f4a-4b5c-8d6e-7f8091a2b3c4", eventID)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+}
+
+func (s *TestSuite) TestEventUserRepository_CheckIn() {
+	repo := eventuser.New(s.db)
+	eventRepo := event.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user2 := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Event",
+		Creator:      user1,
+		Status:       models.StatusPublished,
+		MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive}))
+	require.NoError(s.T(), repo.Register(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationActive}))
+
+	checkedInAt, err := repo.CheckIn(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.False(s.T(), checkedInAt.IsZero())
+
+	_, err = repo.CheckIn(s.ctx, user1, eventID)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+
+	registration, err := repo.Get(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), registration.CheckedInAt)
+
+	attendance, err := repo.GetAttendance(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), &models.Attendance{EventId: eventID, Registered: 2, CheckedIn: 1}, attendance)
+
+	require.NoError(s.T(), repo.Cancel(s.ctx, user2, eventID))
+	_, err = repo.CheckIn(s.ctx, user2, eventID)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+
+	attendance, err = repo.GetAttendance(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, attendance.Registered)
+}
+
+func (s *TestSuite) TestEventUserRepository_Guests() {
+	repo := eventuser.New(s.db)
+	eventRepo := event.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user2 := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Event",
+		Creator:      user1,
+		Status:       models.StatusPublished,
+		MaxAttendees: 5,
+		MaxGuests:    3,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), eventRepo.IncreaseCurrentAttedance(s.ctx, eventID, 3))
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive, Guests: 2}))
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationPending, Guests: 3}))
+
+	require.NoError(s.T(), repo.SetGuests(s.ctx, user1, eventID, 3))
+	require.ErrorIs(s.T(), repo.SetGuests(s.ctx, user1, eventID, 5), repositories.ErrMaxRegistered)
+	require.NoError(s.T(), repo.SetGuests(s.ctx, user2, eventID, 1))
+	require.ErrorIs(s.T(), repo.SetGuests(s.ctx, "9b1c7d2e-3f4a-4b5c-8d6e-7f8091a2b3c4", eventID, 1), repositories.ErrRecordNotFound)
+
+	stored, err := eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 3, stored.MaxGuests)
+	require.Equal(s.T(), 4, stored.CurrentAttendance)
+
+	registration, err := repo.Get(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 3, registration.Guests)
+
+	require.ErrorIs(s.T(), repo.Approve(s.ctx, user2, eventID), repositories.ErrMaxRegistered)
+	require.NoError(s.T(), repo.SetGuests(s.ctx, user1, eventID, 1))
+	require.NoError(s.T(), repo.Approve(s.ctx, user2, eventID))
+
+	attendance, err := repo.GetAttendance(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), &models.Attendance{EventId: eventID, Registered: 2, Guests: 2}, attendance)
+
+	stored, err = eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 4, stored.CurrentAttendance)
+}
+
+func (s *TestSuite) TestEventUserRepository_CreateGroup() {
+	repo := eventuser.New(s.db)
+	eventRepo := event.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user2 := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	user3 := "9b1c7d2e-3f4a-4b5c-8d6e-7f8091a2b3c4"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Event",
+		Creator:      user1,
+		Status:       models.StatusPublished,
+		MaxAttendees: 2,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), eventRepo.IncreaseCurrentAttedance(s.ctx, eventID, 1))
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive}))
+
+	active := &models.Registration{EventId: eventID, Status: models.RegistrationActive}
+
+	_, err = repo.CreateGroup(s.ctx, active, []string{user2, user3})
+	require.ErrorIs(s.T(), err, repositories.ErrMaxRegistered)
+
+	_, err = repo.Get(s.ctx, user2, eventID)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+
+	registered, err := repo.CreateGroup(s.ctx, active, []string{user1, user2})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []string{user2}, registered)
+
+	stored, err := eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, stored.CurrentAttendance)
+
+	registered, err = repo.CreateGroup(s.ctx, &models.Registration{EventId: eventID, Status: models.RegistrationPending}, []string{user3})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []string{user3}, registered)
+
+	registration, err := repo.Get(s.ctx, user3, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.RegistrationPending, registration.Status)
+
+	otherID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Other",
+		Creator:      user1,
+		Status:       models.StatusPublished,
+		MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+	vipID, err := tickettype.New(s.db).Create(s.ctx, &models.TicketType{EventId: otherID, Name: "VIP", Capacity: 1})
+	require.NoError(s.T(), err)
+
+	_, err = repo.CreateGroup(s.ctx, &models.Registration{EventId: otherID, Status: models.RegistrationActive, TicketTypeId: vipID}, []string{user1, user2})
+	require.ErrorIs(s.T(), err, repositories.ErrSoldOut)
+
+	_, err = repo.Get(s.ctx, user1, otherID)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+}
+
+func (s *TestSuite) TestEventUserRepository_Transfer() {
+	repo := eventuser.New(s.db)
+	eventRepo := event.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user2 := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+	user3 := "9b1c7d2e-3f4a-4b5c-8d6e-7f8091a2b3c4"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:          "Event",
+		Creator:        user1,
+		Status:         models.StatusPublished,
+		MaxAttendees:   10,
+		MaxGuests:      2,
+		AllowTransfers: true,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	require.NoError(s.T(), eventRepo.IncreaseCurrentAttedance(s.ctx, eventID, 4))
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive, Guests: 2}))
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Registration{UserId: user3, EventId: eventID, Status: models.RegistrationActive}))
+
+	require.NoError(s.T(), repo.Transfer(s.ctx, eventID, user1, user2))
+	require.ErrorIs(s.T(), repo.Transfer(s.ctx, eventID, user1, user2), repositories.ErrRecordNotFound)
+	require.ErrorIs(s.T(), repo.Transfer(s.ctx, eventID, user2, user3), repositories.ErrAlreadyExists)
+
+	registration, err := repo.Get(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.RegistrationCancelled, registration.Status)
+
+	registration, err = repo.Get(s.ctx, user2, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.RegistrationActive, registration.Status)
+	require.Equal(s.T(), 2, registration.Guests)
+
+	require.NoError(s.T(), repo.Transfer(s.ctx, eventID, user2, user1))
+
+	stored, err := eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.True(s.T(), stored.AllowTransfers)
+	require.Equal(s.T(), 4, stored.CurrentAttendance)
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/repositories/event"
+	eventuser "github.com/Estriper0/EventService/internal/repositories/event_user"
+	"github.com/Estriper0/EventService/internal/repositories/invite"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *TestSuite) TestInviteRepository() {
+	repo := invite.New(s.db)
+	eventRepo := event.New(s.db)
+	userRepo := eventuser.New(s.db)
+	creator := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	invited := "6f1c3a9e-2b4d-4e8a-9c7b-1d2e3f4a5b6c"
+	registered := "0b6e3b52-8f3c-4f0e-a7a4-5d2c1e9f8a7b"
+	stranger := "3c9d2e1f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	rejected := "7d4e5f6a-1b2c-4d3e-9f8a-0b1c2d3e4f5a"
+
+	create := func(title string, visibility string) int {
+		id, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+			Title:        title,
+			Creator:      creator,
+			Status:       models.StatusPublished,
+			MaxAttendees: 10,
+			Visibility:   visibility,
+		}, nil)
+		require.NoError(s.T(), err)
+		return id
+	}
+	publicID := create("Public event", "")
+	create("Unlisted event", models.VisibilityUnlisted)
+	privateID := create("Private event", models.VisibilityPrivate)
+
+	stored, err := eventRepo.GetById(s.ctx, publicID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.VisibilityPublic, stored.Visibility)
+
+	personal := &models.Invite{Code: "personal", EventId: privateID, UserId: invited, CreatedBy: creator}
+	require.NoError(s.T(), repo.Create(s.ctx, personal))
+	require.False(s.T(), personal.CreatedAt.IsZero())
+	require.NoError(s.T(), repo.Create(s.ctx, &models.Invite{Code: "shared", EventId: privateID, CreatedBy: creator}))
+	require.NoError(s.T(), userRepo.Create(s.ctx, &models.Registration{UserId: registered, EventId: privateID, Status: models.RegistrationActive}))
+	require.NoError(s.T(), userRepo.Create(s.ctx, &models.Registration{UserId: rejected, EventId: privateID, Status: models.RegistrationPending}))
+	require.NoError(s.T(), userRepo.Reject(s.ctx, rejected, privateID))
+
+	got, err := repo.GetByCode(s.ctx, privateID, "personal")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), invited, got.UserId)
+	require.Nil(s.T(), got.RevokedAt)
+	_, err = repo.GetByCode(s.ctx, publicID, "personal")
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+
+	titles := func(viewer *models.Viewer) []string {
+		events, err := eventRepo.GetAll(s.ctx, viewer)
+		require.NoError(s.T(), err)
+		res := []string{}
+		for _, event := range events {
+			res = append(res, event.Title)
+		}
+		return res
+	}
+	require.Equal(s.T(), []string{"Private event", "Public event", "Unlisted event"}, titles(nil))
+	require.Equal(s.T(), []string{"Private event", "Public event", "Unlisted event"}, titles(&models.Viewer{UserId: creator}))
+	require.Equal(s.T(), []string{"Private event", "Public event"}, titles(&models.Viewer{UserId: invited}))
+	require.Equal(s.T(), []string{"Private event", "Public event"}, titles(&models.Viewer{UserId: registered}))
+	require.Equal(s.T(), []string{"Public event"}, titles(&models.Viewer{UserId: stranger}))
+	require.Equal(s.T(), []string{"Public event"}, titles(&models.Viewer{UserId: rejected}))
+	require.Equal(s.T(), []string{"Public event"}, titles(&models.Viewer{}))
+
+	byStatus, err := eventRepo.GetAllByStatus(s.ctx, models.StatusPublished, &models.Viewer{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), byStatus, 1)
+	byCreator, err := eventRepo.GetAllByCreator(s.ctx, creator, false, &models.Viewer{UserId: stranger})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), byCreator, 1)
+	byUser, err := eventRepo.GetAllByUser(s.ctx, registered, &models.Viewer{UserId: registered})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), byUser, 1)
+	byUser, err = eventRepo.GetAllByUser(s.ctx, registered, &models.Viewer{UserId: stranger})
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), byUser)
+
+	for _, tt := range []struct {
+		user string
+		want bool
+	}{{invited, true}, {registered, true}, {rejected, false}, {stranger, false}, {"", false}} {
+		ok, err := repo.IsInvited(s.ctx, privateID, tt.user)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), tt.want, ok, tt.user)
+	}
+
+	require.NoError(s.T(), repo.Revoke(s.ctx, privateID, "personal"))
+	require.ErrorIs(s.T(), repo.Revoke(s.ctx, privateID, "personal"), repositories.ErrRecordNotFound)
+	ok, err := repo.IsInvited(s.ctx, privateID, invited)
+	require.NoError(s.T(), err)
+	require.False(s.T(), ok)
+	require.Equal(s.T(), []string{"Public event"}, titles(&models.Viewer{UserId: invited}))
+
+	invites, err := repo.GetAllByEvent(s.ctx, privateID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), invites, 2)
+}
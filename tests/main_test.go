@@ -97,6 +97,6 @@ func (s *TestSuite) TearDownSuite() {
 }
 
 func (s *TestSuite) SetupTest() {
-	_, err := s.db.ExecContext(s.ctx, "TRUNCATE TABLE event.events, event.event_user CASCADE;")
+	_, err := s.db.ExecContext(s.ctx, "TRUNCATE TABLE event.events, event.event_user, event.event_history, event.ticket_types, event.registration_questions, event.event_invites, event.event_organizers CASCADE;")
 	s.Require().NoError(err)
 }
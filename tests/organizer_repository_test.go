@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/repositories/event"
+	eventhistory "github.com/Estriper0/EventService/internal/repositories/event_history"
+	"github.com/Estriper0/EventService/internal/repositories/organizer"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *TestSuite) TestOrganizerRepository() {
+	repo := organizer.New(s.db)
+	eventRepo := event.New(s.db)
+	owner := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	editor := "6f1c3a9e-2b4d-4e8a-9c7b-1d2e3f4a5b6c"
+	viewer := "0b6e3b52-8f3c-4f0e-a7a4-5d2c1e9f8a7b"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Shared event",
+		Creator:      owner,
+		Status:       models.StatusPublished,
+		MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+	otherID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Own event",
+		Creator:      editor,
+		Status:       models.StatusPublished,
+		MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	role, err := repo.GetRole(s.ctx, eventID, owner)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.OrganizerOwner, role)
+
+	added := &models.Organizer{EventId: eventID, UserId: editor, Role: models.OrganizerViewer}
+	require.NoError(s.T(), repo.Add(s.ctx, added))
+	require.False(s.T(), added.AddedAt.IsZero())
+	require.NoError(s.T(), repo.Add(s.ctx, &models.Organizer{EventId: eventID, UserId: editor, Role: models.OrganizerEditor}))
+	require.NoError(s.T(), repo.Add(s.ctx, &models.Organizer{EventId: eventID, UserId: viewer, Role: models.OrganizerViewer}))
+	require.ErrorIs(s.T(), repo.Add(s.ctx, &models.Organizer{EventId: eventID, UserId: owner, Role: models.OrganizerViewer}), repositories.ErrAlreadyExists)
+
+	role, err = repo.GetRole(s.ctx, eventID, editor)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.OrganizerEditor, role)
+	_, err = repo.GetRole(s.ctx, otherID, viewer)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+
+	organizers, err := repo.GetAllByEvent(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), organizers, 3)
+	require.Equal(s.T(), owner, organizers[0].UserId)
+	require.Equal(s.T(), models.OrganizerOwner, organizers[0].Role)
+
+	own, err := eventRepo.GetAllByCreator(s.ctx, editor, false, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), own, 1)
+	organized, err := eventRepo.GetAllByCreator(s.ctx, editor, true, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), organized, 2)
+
+	version, err := repo.TransferOwnership(s.ctx, eventID, editor, &models.EventHistory{
+		Action:   models.ActionTransfer,
+		Actor:    owner,
+		Changes:  map[string]models.FieldChange{models.FieldCreator: {Old: owner, New: editor}},
+		Snapshot: &models.EventResponse{Title: "Shared event", Creator: editor, Status: models.StatusPublished},
+	})
+	require.NoError(s.T(), err)
+	history, err := eventhistory.New(s.db).GetRevision(s.ctx, eventID, version)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), version, history.Revision)
+	require.Equal(s.T(), models.ActionTransfer, history.Action)
+	require.Equal(s.T(), owner, history.Actor)
+	require.Equal(s.T(), editor, history.Snapshot.Creator)
+	stored, err := eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), editor, stored.Creator)
+	require.Equal(s.T(), version, stored.Version)
+	role, err = repo.GetRole(s.ctx, eventID, owner)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.OrganizerEditor, role)
+	role, err = repo.GetRole(s.ctx, eventID, editor)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), models.OrganizerOwner, role)
+
+	_, err = repo.TransferOwnership(s.ctx, 0, editor, nil)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+
+	require.ErrorIs(s.T(), repo.Remove(s.ctx, eventID, editor), repositories.ErrRecordNotFound)
+	require.NoError(s.T(), repo.Remove(s.ctx, eventID, viewer))
+	require.ErrorIs(s.T(), repo.Remove(s.ctx, eventID, viewer), repositories.ErrRecordNotFound)
+}
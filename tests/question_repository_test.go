@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/repositories/event"
+	eventuser "github.com/Estriper0/EventService/internal/repositories/event_user"
+	"github.com/Estriper0/EventService/internal/repositories/question"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *TestSuite) TestQuestionRepository() {
+	repo := question.New(s.db)
+	eventRepo := event.New(s.db)
+	userRepo := eventuser.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Event",
+		Creator:      user1,
+		Status:       models.StatusPublished,
+		MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	sizeID, err := repo.Create(s.ctx, &models.Question{EventId: eventID, Position: 2, Label: "T-shirt size", Type: models.QuestionSingleChoice, Options: []string{"S", "M", "L"}, Required: true})
+	require.NoError(s.T(), err)
+	dietID, err := repo.Create(s.ctx, &models.Question{EventId: eventID, Position: 1, Label: "Dietary requirements", Type: models.QuestionText})
+	require.NoError(s.T(), err)
+
+	questions, err := repo.GetAllByEvent(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), questions, 2)
+	require.Equal(s.T(), dietID, questions[0].Id)
+	require.Empty(s.T(), questions[0].Options)
+	require.Equal(s.T(), []string{"S", "M", "L"}, questions[1].Options)
+	require.True(s.T(), questions[1].Required)
+
+	answers := []models.Answer{{QuestionId: sizeID, Values: []string{"M"}}}
+	require.NoError(s.T(), userRepo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive, Answers: answers}))
+
+	registration, err := userRepo.Get(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), answers, registration.Answers)
+
+	require.NoError(s.T(), repo.Delete(s.ctx, dietID, eventID))
+	require.ErrorIs(s.T(), repo.Delete(s.ctx, dietID, eventID), repositories.ErrRecordNotFound)
+
+	questions, err = repo.GetAllByEvent(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), questions, 1)
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"github.com/Estriper0/EventService/internal/models"
+	"github.com/Estriper0/EventService/internal/repositories"
+	"github.com/Estriper0/EventService/internal/repositories/event"
+	eventuser "github.com/Estriper0/EventService/internal/repositories/event_user"
+	tickettype "github.com/Estriper0/EventService/internal/repositories/ticket_type"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *TestSuite) TestTicketTypeRepository() {
+	repo := tickettype.New(s.db)
+	eventRepo := event.New(s.db)
+	userRepo := eventuser.New(s.db)
+	user1 := "ea27ecf4-02b1-453d-965d-408253a874b9"
+	user2 := "4f0c2b8e-9d3a-4c61-8a57-2e1d6b7f9c03"
+
+	eventID, err := eventRepo.Create(s.ctx, &models.EventCreateRequest{
+		Title:        "Event",
+		Creator:      user1,
+		Status:       models.StatusPublished,
+		MaxAttendees: 10,
+	}, nil)
+	require.NoError(s.T(), err)
+
+	vipID, err := repo.Create(s.ctx, &models.TicketType{EventId: eventID, Name: "VIP", Capacity: 1})
+	require.NoError(s.T(), err)
+	_, err = repo.Create(s.ctx, &models.TicketType{EventId: eventID, Name: "VIP", Capacity: 5})
+	require.ErrorIs(s.T(), err, repositories.ErrAlreadyExists)
+	speakerID, err := repo.Create(s.ctx, &models.TicketType{EventId: eventID, Name: "Speaker", Capacity: 5, Hidden: true})
+	require.NoError(s.T(), err)
+
+	ticketTypes, err := repo.GetAllByEvent(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), ticketTypes, 2)
+	require.True(s.T(), ticketTypes[1].Hidden)
+
+	require.NoError(s.T(), repo.Reserve(s.ctx, vipID, eventID, 1))
+	require.ErrorIs(s.T(), repo.Reserve(s.ctx, vipID, eventID, 1), repositories.ErrSoldOut)
+	require.NoError(s.T(), userRepo.Create(s.ctx, &models.Registration{UserId: user1, EventId: eventID, Status: models.RegistrationActive, TicketTypeId: vipID}))
+
+	registration, err := userRepo.Get(s.ctx, user1, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), vipID, registration.TicketTypeId)
+
+	vip, err := repo.GetById(s.ctx, vipID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, vip.Sold)
+
+	stored, err := eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, stored.CurrentAttendance)
+
+	require.NoError(s.T(), userRepo.Create(s.ctx, &models.Registration{UserId: user2, EventId: eventID, Status: models.RegistrationPending, TicketTypeId: vipID}))
+	require.ErrorIs(s.T(), userRepo.Approve(s.ctx, user2, eventID), repositories.ErrSoldOut)
+
+	require.NoError(s.T(), userRepo.Cancel(s.ctx, user1, eventID))
+	require.NoError(s.T(), userRepo.Approve(s.ctx, user2, eventID))
+	require.ErrorIs(s.T(), userRepo.SetGuests(s.ctx, user2, eventID, 1), repositories.ErrSoldOut)
+
+	vip, err = repo.GetById(s.ctx, vipID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, vip.Sold)
+
+	require.ErrorIs(s.T(), repo.Reserve(s.ctx, speakerID, eventID, 6), repositories.ErrSoldOut)
+	require.NoError(s.T(), repo.Reserve(s.ctx, speakerID, eventID, 3))
+
+	stored, err = eventRepo.GetById(s.ctx, eventID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 4, stored.CurrentAttendance)
+
+	_, err = repo.GetById(s.ctx, vipID+100)
+	require.ErrorIs(s.T(), err, repositories.ErrRecordNotFound)
+}